@@ -0,0 +1,200 @@
+package bm
+
+// AABB2 represents an axis-aligned bounding box in 2D space, spanning from Min to Max inclusive.
+type AABB2[T Numeric] struct {
+	Min, Max Vec2[T]
+}
+
+/**
+ * NewAABB2 returns the box spanning the two corners a and b, which may be given in any order.
+ * For example:
+ *   NewAABB2(Vec2[int]{1, 5}, Vec2[int]{3, 2}) returns AABB2[int]{Min: {1, 2}, Max: {3, 5}}
+ */
+func NewAABB2[T Numeric](a, b Vec2[T]) AABB2[T] {
+	return AABB2[T]{Min: a.Min(b), Max: a.Max(b)}
+}
+
+/**
+ * AABB2FromPoints returns the smallest box containing every point. If no points are given, it returns the zero box.
+ * For example:
+ *   AABB2FromPoints(Vec2[int]{1, 2}, Vec2[int]{-1, 4}) returns AABB2[int]{Min: {-1, 2}, Max: {1, 4}}
+ */
+func AABB2FromPoints[T Numeric](points ...Vec2[T]) AABB2[T] {
+	if len(points) == 0 {
+		return AABB2[T]{}
+	}
+	box := AABB2[T]{Min: points[0], Max: points[0]}
+	for _, p := range points[1:] {
+		box = box.ExpandToPoint(p)
+	}
+	return box
+}
+
+/**
+ * Union returns the smallest box containing both boxes.
+ * For example:
+ *   AABB2[int]{Max: {1, 1}}.Union(AABB2[int]{Min: {2, 2}, Max: {3, 3}}) returns AABB2[int]{Max: {3, 3}}
+ */
+func (b AABB2[T]) Union(other AABB2[T]) AABB2[T] {
+	return AABB2[T]{Min: b.Min.Min(other.Min), Max: b.Max.Max(other.Max)}
+}
+
+/**
+ * Intersection returns the overlapping region of both boxes and a boolean indicating whether they overlap at all.
+ * For example:
+ *   AABB2[int]{Max: {2, 2}}.Intersection(AABB2[int]{Min: {1, 1}, Max: {3, 3}}) returns (AABB2[int]{Min: {1, 1}, Max: {2, 2}}, true)
+ */
+func (b AABB2[T]) Intersection(other AABB2[T]) (AABB2[T], bool) {
+	r := AABB2[T]{Min: b.Min.Max(other.Min), Max: b.Max.Min(other.Max)}
+	if r.Min.X > r.Max.X || r.Min.Y > r.Max.Y {
+		return AABB2[T]{}, false
+	}
+	return r, true
+}
+
+/**
+ * Expand returns the box grown by amount on every side.
+ * For example:
+ *   AABB2[int]{Max: {1, 1}}.Expand(1) returns AABB2[int]{Min: {-1, -1}, Max: {2, 2}}
+ */
+func (b AABB2[T]) Expand(amount T) AABB2[T] {
+	d := Vec2[T]{X: amount, Y: amount}
+	return AABB2[T]{Min: b.Min.Sub(d), Max: b.Max.Add(d)}
+}
+
+/**
+ * ExpandToPoint returns the smallest box containing both the box and p.
+ * For example:
+ *   AABB2[int]{Max: {1, 1}}.ExpandToPoint(Vec2[int]{2, 0}) returns AABB2[int]{Max: {2, 1}}
+ */
+func (b AABB2[T]) ExpandToPoint(p Vec2[T]) AABB2[T] {
+	return AABB2[T]{Min: b.Min.Min(p), Max: b.Max.Max(p)}
+}
+
+/**
+ * Contains reports whether the point p lies inside or on the boundary of the box.
+ * For example:
+ *   AABB2[int]{Max: {2, 2}}.Contains(Vec2[int]{1, 2}) returns true
+ */
+func (b AABB2[T]) Contains(p Vec2[T]) bool {
+	return p.X >= b.Min.X && p.X <= b.Max.X &&
+		p.Y >= b.Min.Y && p.Y <= b.Max.Y
+}
+
+/**
+ * ContainsBox reports whether the other box lies entirely inside the box.
+ * For example:
+ *   AABB2[int]{Max: {4, 4}}.ContainsBox(AABB2[int]{Min: {1, 1}, Max: {2, 2}}) returns true
+ */
+func (b AABB2[T]) ContainsBox(other AABB2[T]) bool {
+	return b.Contains(other.Min) && b.Contains(other.Max)
+}
+
+/**
+ * Overlaps reports whether the two boxes share any point, including touching edges.
+ * For example:
+ *   AABB2[int]{Max: {1, 1}}.Overlaps(AABB2[int]{Min: {1, 1}, Max: {2, 2}}) returns true
+ */
+func (b AABB2[T]) Overlaps(other AABB2[T]) bool {
+	return b.Min.X <= other.Max.X && b.Max.X >= other.Min.X &&
+		b.Min.Y <= other.Max.Y && b.Max.Y >= other.Min.Y
+}
+
+/**
+ * Center returns the midpoint of the box.
+ * For example:
+ *   AABB2[float64]{Max: {2, 4}}.Center() returns Vec2[float64]{1, 2}
+ */
+func (b AABB2[T]) Center() Vec2[T] {
+	return b.Min.Add(b.Max).Div(Vec2[T]{X: 2, Y: 2})
+}
+
+/**
+ * Extents returns the half-size of the box along each axis.
+ * For example:
+ *   AABB2[float64]{Max: {2, 4}}.Extents() returns Vec2[float64]{1, 2}
+ */
+func (b AABB2[T]) Extents() Vec2[T] {
+	return b.Size().Div(Vec2[T]{X: 2, Y: 2})
+}
+
+/**
+ * Size returns the full size of the box along each axis.
+ * For example:
+ *   AABB2[int]{Min: {1, 1}, Max: {2, 4}}.Size() returns Vec2[int]{1, 3}
+ */
+func (b AABB2[T]) Size() Vec2[T] {
+	return b.Max.Sub(b.Min)
+}
+
+/**
+ * Area returns the area enclosed by the box.
+ * For example:
+ *   AABB2[int]{Max: {2, 3}}.Area() returns 6
+ */
+func (b AABB2[T]) Area() T {
+	s := b.Size()
+	return s.X * s.Y
+}
+
+/**
+ * Perimeter returns the total length of the four edges of the box.
+ * For example:
+ *   AABB2[int]{Max: {2, 3}}.Perimeter() returns 10
+ */
+func (b AABB2[T]) Perimeter() T {
+	s := b.Size()
+	return 2 * (s.X + s.Y)
+}
+
+/**
+ * ClosestPoint returns the point inside or on the box nearest to p.
+ * For example:
+ *   AABB2[int]{Max: {1, 1}}.ClosestPoint(Vec2[int]{3, 0}) returns Vec2[int]{1, 0}
+ */
+func (b AABB2[T]) ClosestPoint(p Vec2[T]) Vec2[T] {
+	return p.Max(b.Min).Min(b.Max)
+}
+
+/**
+ * Corners returns the four corner points of the box.
+ * For example:
+ *   AABB2[int]{Max: {1, 1}}.Corners()[3] returns Vec2[int]{1, 1}
+ */
+func (b AABB2[T]) Corners() [4]Vec2[T] {
+	return [4]Vec2[T]{
+		b.Min,
+		{X: b.Max.X, Y: b.Min.Y},
+		{X: b.Min.X, Y: b.Max.Y},
+		b.Max,
+	}
+}
+
+/**
+ * Transform returns the smallest axis-aligned box enclosing the box after it has been transformed by the 2D affine matrix m.
+ * For example:
+ *   AABB2[float64]{Max: {1, 1}}.Transform(Translate2D(Vec2[float64]{1, 0})) returns AABB2[float64]{Min: {1, 0}, Max: {2, 1}}
+ */
+func (b AABB2[T]) Transform(m Mat3[T]) AABB2[T] {
+	corners := b.Corners()
+	for i := range corners {
+		corners[i] = m.TransformPoint2D(corners[i])
+	}
+	return AABB2FromPoints(corners[:]...)
+}
+
+/**
+ * Support returns the corner of the box farthest along dir, so an AABB2 can be used with GJK and EPA.
+ * For example:
+ *   AABB2[float64]{Max: {1, 1}}.Support(Vec2[float64]{1, -1}) returns Vec2[float64]{1, 0}
+ */
+func (b AABB2[T]) Support(dir Vec2[T]) Vec2[T] {
+	p := b.Min
+	if dir.X > 0 {
+		p.X = b.Max.X
+	}
+	if dir.Y > 0 {
+		p.Y = b.Max.Y
+	}
+	return p
+}
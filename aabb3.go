@@ -0,0 +1,238 @@
+package bm
+
+// AABB3 represents an axis-aligned bounding box in 3D space, spanning from Min to Max inclusive.
+type AABB3[T Numeric] struct {
+	Min, Max Vec3[T]
+}
+
+/**
+ * NewAABB3 returns the box spanning the two corners a and b, which may be given in any order.
+ * For example:
+ *   NewAABB3(Vec3[int]{1, 5, 0}, Vec3[int]{3, 2, 1}) returns AABB3[int]{Min: {1, 2, 0}, Max: {3, 5, 1}}
+ */
+func NewAABB3[T Numeric](a, b Vec3[T]) AABB3[T] {
+	return AABB3[T]{Min: a.Min(b), Max: a.Max(b)}
+}
+
+/**
+ * AABB3FromPoints returns the smallest box containing every point. If no points are given, it returns the zero box.
+ * For example:
+ *   AABB3FromPoints(Vec3[int]{1, 2, 3}, Vec3[int]{-1, 4, 0}) returns AABB3[int]{Min: {-1, 2, 0}, Max: {1, 4, 3}}
+ */
+func AABB3FromPoints[T Numeric](points ...Vec3[T]) AABB3[T] {
+	if len(points) == 0 {
+		return AABB3[T]{}
+	}
+	box := AABB3[T]{Min: points[0], Max: points[0]}
+	for _, p := range points[1:] {
+		box = box.ExpandToPoint(p)
+	}
+	return box
+}
+
+/**
+ * Union returns the smallest box containing both boxes.
+ * For example:
+ *   AABB3[int]{Max: {1, 1, 1}}.Union(AABB3[int]{Min: {2, 2, 2}, Max: {3, 3, 3}}) returns AABB3[int]{Max: {3, 3, 3}}
+ */
+func (b AABB3[T]) Union(other AABB3[T]) AABB3[T] {
+	return AABB3[T]{Min: b.Min.Min(other.Min), Max: b.Max.Max(other.Max)}
+}
+
+/**
+ * Intersection returns the overlapping region of both boxes and a boolean indicating whether they overlap at all.
+ * For example:
+ *   AABB3[int]{Max: {2, 2, 2}}.Intersection(AABB3[int]{Min: {1, 1, 1}, Max: {3, 3, 3}}) returns (AABB3[int]{Min: {1, 1, 1}, Max: {2, 2, 2}}, true)
+ */
+func (b AABB3[T]) Intersection(other AABB3[T]) (AABB3[T], bool) {
+	r := AABB3[T]{Min: b.Min.Max(other.Min), Max: b.Max.Min(other.Max)}
+	if r.Min.X > r.Max.X || r.Min.Y > r.Max.Y || r.Min.Z > r.Max.Z {
+		return AABB3[T]{}, false
+	}
+	return r, true
+}
+
+/**
+ * Expand returns the box grown by amount on every side.
+ * For example:
+ *   AABB3[int]{Max: {1, 1, 1}}.Expand(1) returns AABB3[int]{Min: {-1, -1, -1}, Max: {2, 2, 2}}
+ */
+func (b AABB3[T]) Expand(amount T) AABB3[T] {
+	d := Vec3[T]{X: amount, Y: amount, Z: amount}
+	return AABB3[T]{Min: b.Min.Sub(d), Max: b.Max.Add(d)}
+}
+
+/**
+ * ExpandToPoint returns the smallest box containing both the box and p.
+ * For example:
+ *   AABB3[int]{Max: {1, 1, 1}}.ExpandToPoint(Vec3[int]{2, 0, 0}) returns AABB3[int]{Max: {2, 1, 1}}
+ */
+func (b AABB3[T]) ExpandToPoint(p Vec3[T]) AABB3[T] {
+	return AABB3[T]{Min: b.Min.Min(p), Max: b.Max.Max(p)}
+}
+
+/**
+ * Contains reports whether the point p lies inside or on the boundary of the box.
+ * For example:
+ *   AABB3[int]{Max: {2, 2, 2}}.Contains(Vec3[int]{1, 2, 0}) returns true
+ */
+func (b AABB3[T]) Contains(p Vec3[T]) bool {
+	return p.X >= b.Min.X && p.X <= b.Max.X &&
+		p.Y >= b.Min.Y && p.Y <= b.Max.Y &&
+		p.Z >= b.Min.Z && p.Z <= b.Max.Z
+}
+
+/**
+ * ContainsBox reports whether the other box lies entirely inside the box.
+ * For example:
+ *   AABB3[int]{Max: {4, 4, 4}}.ContainsBox(AABB3[int]{Min: {1, 1, 1}, Max: {2, 2, 2}}) returns true
+ */
+func (b AABB3[T]) ContainsBox(other AABB3[T]) bool {
+	return b.Contains(other.Min) && b.Contains(other.Max)
+}
+
+/**
+ * Overlaps reports whether the two boxes share any point, including touching faces.
+ * For example:
+ *   AABB3[int]{Max: {1, 1, 1}}.Overlaps(AABB3[int]{Min: {1, 1, 1}, Max: {2, 2, 2}}) returns true
+ */
+func (b AABB3[T]) Overlaps(other AABB3[T]) bool {
+	return b.Min.X <= other.Max.X && b.Max.X >= other.Min.X &&
+		b.Min.Y <= other.Max.Y && b.Max.Y >= other.Min.Y &&
+		b.Min.Z <= other.Max.Z && b.Max.Z >= other.Min.Z
+}
+
+/**
+ * Center returns the midpoint of the box.
+ * For example:
+ *   AABB3[float64]{Max: {2, 4, 6}}.Center() returns Vec3[float64]{1, 2, 3}
+ */
+func (b AABB3[T]) Center() Vec3[T] {
+	return b.Min.Add(b.Max).Div(Vec3[T]{X: 2, Y: 2, Z: 2})
+}
+
+/**
+ * Extents returns the half-size of the box along each axis.
+ * For example:
+ *   AABB3[float64]{Max: {2, 4, 6}}.Extents() returns Vec3[float64]{1, 2, 3}
+ */
+func (b AABB3[T]) Extents() Vec3[T] {
+	return b.Size().Div(Vec3[T]{X: 2, Y: 2, Z: 2})
+}
+
+/**
+ * Size returns the full size of the box along each axis.
+ * For example:
+ *   AABB3[int]{Min: {1, 1, 1}, Max: {2, 4, 6}}.Size() returns Vec3[int]{1, 3, 5}
+ */
+func (b AABB3[T]) Size() Vec3[T] {
+	return b.Max.Sub(b.Min)
+}
+
+/**
+ * SurfaceArea returns the total area of the six faces of the box.
+ * For example:
+ *   AABB3[int]{Max: {1, 2, 3}}.SurfaceArea() returns 22
+ */
+func (b AABB3[T]) SurfaceArea() T {
+	s := b.Size()
+	return 2 * (s.X*s.Y + s.Y*s.Z + s.Z*s.X)
+}
+
+/**
+ * Volume returns the volume enclosed by the box.
+ * For example:
+ *   AABB3[int]{Max: {1, 2, 3}}.Volume() returns 6
+ */
+func (b AABB3[T]) Volume() T {
+	s := b.Size()
+	return s.X * s.Y * s.Z
+}
+
+/**
+ * ClosestPoint returns the point inside or on the box nearest to p.
+ * For example:
+ *   AABB3[int]{Max: {1, 1, 1}}.ClosestPoint(Vec3[int]{3, 0, -2}) returns Vec3[int]{1, 0, 0}
+ */
+func (b AABB3[T]) ClosestPoint(p Vec3[T]) Vec3[T] {
+	return p.Max(b.Min).Min(b.Max)
+}
+
+/**
+ * Corners returns the eight corner points of the box.
+ * For example:
+ *   AABB3[int]{Max: {1, 1, 1}}.Corners()[7] returns Vec3[int]{1, 1, 1}
+ */
+func (b AABB3[T]) Corners() [8]Vec3[T] {
+	var corners [8]Vec3[T]
+	for i := range corners {
+		corners[i] = b.Min
+		if i&1 != 0 {
+			corners[i].X = b.Max.X
+		}
+		if i&2 != 0 {
+			corners[i].Y = b.Max.Y
+		}
+		if i&4 != 0 {
+			corners[i].Z = b.Max.Z
+		}
+	}
+	return corners
+}
+
+/**
+ * Transform returns the smallest axis-aligned box enclosing the box after it has been transformed by m.
+ * Affine matrices use the fast per-axis method; projective matrices transform all eight corners.
+ * For example:
+ *   AABB3[float64]{Max: {1, 1, 1}}.Transform(TranslateMat4(Vec3[float64]{1, 0, 0})) returns AABB3[float64]{Min: {1, 0, 0}, Max: {2, 1, 1}}
+ */
+func (b AABB3[T]) Transform(m Mat4[T]) AABB3[T] {
+	if m[3][0] != 0 || m[3][1] != 0 || m[3][2] != 0 || m[3][3] != 1 {
+		corners := b.Corners()
+		for i := range corners {
+			corners[i] = m.TransformPoint(corners[i])
+		}
+		return AABB3FromPoints(corners[:]...)
+	}
+
+	min := [3]T{m[0][3], m[1][3], m[2][3]}
+	max := min
+	bMin := [3]T{b.Min.X, b.Min.Y, b.Min.Z}
+	bMax := [3]T{b.Max.X, b.Max.Y, b.Max.Z}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			e := m[i][j] * bMin[j]
+			f := m[i][j] * bMax[j]
+			if e < f {
+				min[i] += e
+				max[i] += f
+			} else {
+				min[i] += f
+				max[i] += e
+			}
+		}
+	}
+	return AABB3[T]{
+		Min: Vec3[T]{X: min[0], Y: min[1], Z: min[2]},
+		Max: Vec3[T]{X: max[0], Y: max[1], Z: max[2]},
+	}
+}
+
+/**
+ * Support returns the corner of the box farthest along dir, so an AABB3 can be used with GJK and EPA.
+ * For example:
+ *   AABB3[float64]{Max: {1, 1, 1}}.Support(Vec3[float64]{1, -1, 1}) returns Vec3[float64]{1, 0, 1}
+ */
+func (b AABB3[T]) Support(dir Vec3[T]) Vec3[T] {
+	p := b.Min
+	if dir.X > 0 {
+		p.X = b.Max.X
+	}
+	if dir.Y > 0 {
+		p.Y = b.Max.Y
+	}
+	if dir.Z > 0 {
+		p.Z = b.Max.Z
+	}
+	return p
+}
@@ -0,0 +1,64 @@
+package bm
+
+import (
+	"testing"
+)
+
+// TestAABB3 tests construction, set operations and measurements of AABB3.
+func TestAABB3(t *testing.T) {
+	box := AABB3FromPoints(Vec3[int]{1, 2, 3}, Vec3[int]{-1, 4, 0}, Vec3[int]{0, 0, 1})
+	if expected := (AABB3[int]{Min: Vec3[int]{-1, 0, 0}, Max: Vec3[int]{1, 4, 3}}); box != expected {
+		t.Errorf("AABB3FromPoints() = %v, want %v", box, expected)
+	}
+	if got := box.Volume(); got != 24 {
+		t.Errorf("AABB3 Volume() = %v, want %v", got, 24)
+	}
+	if got := box.SurfaceArea(); got != 52 {
+		t.Errorf("AABB3 SurfaceArea() = %v, want %v", got, 52)
+	}
+
+	other := AABB3[int]{Min: Vec3[int]{0, 3, 2}, Max: Vec3[int]{5, 5, 5}}
+	inter, ok := box.Intersection(other)
+	if expected := (AABB3[int]{Min: Vec3[int]{0, 3, 2}, Max: Vec3[int]{1, 4, 3}}); !ok || inter != expected {
+		t.Errorf("AABB3 Intersection() = %v, %v, want %v, true", inter, ok, expected)
+	}
+	if _, ok := box.Intersection(other.Expand(-2)); ok {
+		t.Errorf("AABB3 Intersection() reported overlap for disjoint boxes")
+	}
+	if expected := (AABB3[int]{Min: Vec3[int]{-1, 0, 0}, Max: Vec3[int]{5, 5, 5}}); box.Union(other) != expected {
+		t.Errorf("AABB3 Union() = %v, want %v", box.Union(other), expected)
+	}
+	if got, want := box.ClosestPoint(Vec3[int]{5, -3, 2}), (Vec3[int]{1, 0, 2}); got != want {
+		t.Errorf("AABB3 ClosestPoint() = %v, want %v", got, want)
+	}
+}
+
+// TestAABB3Transform tests that a rotated box is enclosed by the transformed box.
+func TestAABB3Transform(t *testing.T) {
+	box := AABB3[float64]{Min: Vec3[float64]{-1, -1, -1}, Max: Vec3[float64]{1, 1, 1}}
+	m := TranslateMat4(NewVec3[float64](10, 0, 0)).Rotated(NewVec3[float64](0, 0, 1), Pi/4)
+	got := box.Transform(m)
+	r := Sqrt(2.0)
+	expected := AABB3[float64]{Min: Vec3[float64]{10 - r, -r, -1}, Max: Vec3[float64]{10 + r, r, 1}}
+
+	if !vec3ApproxEqual(got.Min, expected.Min, 1e-12) || !vec3ApproxEqual(got.Max, expected.Max, 1e-12) {
+		t.Errorf("AABB3 Transform() = %v, want %v", got, expected)
+	}
+}
+
+// TestAABB2 tests containment and overlap of AABB2.
+func TestAABB2(t *testing.T) {
+	box := NewAABB2(Vec2[int]{4, 0}, Vec2[int]{0, 2})
+	if !box.Contains(Vec2[int]{4, 1}) || box.Contains(Vec2[int]{5, 1}) {
+		t.Errorf("AABB2 Contains() is wrong for %v", box)
+	}
+	if !box.Overlaps(AABB2[int]{Min: Vec2[int]{4, 2}, Max: Vec2[int]{6, 6}}) {
+		t.Errorf("AABB2 Overlaps() = false for touching boxes")
+	}
+	if got := box.Perimeter(); got != 12 {
+		t.Errorf("AABB2 Perimeter() = %v, want %v", got, 12)
+	}
+	if got, want := box.Center(), (Vec2[int]{2, 1}); got != want {
+		t.Errorf("AABB2 Center() = %v, want %v", got, want)
+	}
+}
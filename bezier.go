@@ -0,0 +1,348 @@
+package bm
+
+import "math"
+
+const (
+	// bezierRootTolerance is the parameter interval width at which bernsteinRoots stops subdividing.
+	bezierRootTolerance = 1e-12
+	// bezierNewtonIterations bounds the Newton refinement of ClosestPoint.
+	bezierNewtonIterations = 32
+)
+
+// CurvePoint is the vector arithmetic the curve types are built on. Vec2, Vec3 and Vec4 satisfy it.
+type CurvePoint[V any, T Numeric] interface {
+	Add(other V) V
+	Sub(other V) V
+	Scale(scalar T) V
+	ScalarDiv(scalar T) V
+	Dot(other V) T
+	Lerp(other V, t T) V
+}
+
+// BezierCurve2 is a 2D Bézier curve of any degree given by its control points. A curve with n+1 control points has
+// degree n and is parameterized over t in [0, 1]. The methods are meant for float types.
+type BezierCurve2[T Numeric] []Vec2[T]
+
+// BezierCurve3 is a 3D Bézier curve of any degree given by its control points. A curve with n+1 control points has
+// degree n and is parameterized over t in [0, 1]. The methods are meant for float types.
+type BezierCurve3[T Numeric] []Vec3[T]
+
+/**
+ * NewBezierCurve2 returns the Bézier curve with the given control points, which are copied.
+ * For example:
+ *   NewBezierCurve2(Vec2[float64]{0, 0}, Vec2[float64]{1, 2}, Vec2[float64]{2, 0}) returns a quadratic curve
+ */
+func NewBezierCurve2[T Numeric](points ...Vec2[T]) BezierCurve2[T] {
+	c := make(BezierCurve2[T], len(points))
+	copy(c, points)
+	return c
+}
+
+/**
+ * NewBezierCurve3 returns the Bézier curve with the given control points, which are copied.
+ * For example:
+ *   NewBezierCurve3(Vec3[float64]{0, 0, 0}, Vec3[float64]{1, 1, 1}) returns the line segment between the two points
+ */
+func NewBezierCurve3[T Numeric](points ...Vec3[T]) BezierCurve3[T] {
+	c := make(BezierCurve3[T], len(points))
+	copy(c, points)
+	return c
+}
+
+/**
+ * Degree returns the degree of the curve, one less than the number of control points.
+ * For example:
+ *   BezierCurve2[float64]{{0, 0}, {1, 2}, {2, 0}}.Degree() returns 2
+ */
+func (c BezierCurve2[T]) Degree() int {
+	return len(c) - 1
+}
+
+/**
+ * At returns the point of the curve at parameter t by de Casteljau's algorithm. It panics if the curve has no control
+ * points.
+ * For example:
+ *   BezierCurve2[float64]{{0, 0}, {1, 2}, {2, 0}}.At(0.5) returns Vec2[float64]{1, 1}
+ */
+func (c BezierCurve2[T]) At(t T) Vec2[T] {
+	return bezierAt([]Vec2[T](c), t)
+}
+
+/**
+ * Derivative returns the hodograph of the curve, the Bézier curve of one lower degree whose value at t is the tangent
+ * of c at t. The derivative of a single point is the zero curve.
+ * For example:
+ *   BezierCurve2[float64]{{0, 0}, {1, 2}, {2, 0}}.Derivative() returns BezierCurve2[float64]{{2, 4}, {2, -4}}
+ */
+func (c BezierCurve2[T]) Derivative() BezierCurve2[T] {
+	return bezierDerivative([]Vec2[T](c))
+}
+
+/**
+ * Split divides the curve at parameter t into two curves of the same degree. The first covers [0, t] and the second
+ * [t, 1], each reparameterized over [0, 1].
+ * For example:
+ *   BezierCurve2[float64]{{0, 0}, {1, 2}, {2, 0}}.Split(0.5) returns {{0, 0}, {0.5, 1}, {1, 1}} and {{1, 1}, {1.5, 1}, {2, 0}}
+ */
+func (c BezierCurve2[T]) Split(t T) (BezierCurve2[T], BezierCurve2[T]) {
+	left, right := bezierSplit([]Vec2[T](c), t)
+	return left, right
+}
+
+/**
+ * Elevate returns the same curve expressed with one more control point.
+ * For example:
+ *   BezierCurve2[float64]{{0, 0}, {2, 2}}.Elevate() returns BezierCurve2[float64]{{0, 0}, {1, 1}, {2, 2}}
+ */
+func (c BezierCurve2[T]) Elevate() BezierCurve2[T] {
+	return bezierElevate([]Vec2[T](c))
+}
+
+/**
+ * Bounds returns the tightest axis-aligned box containing the curve, found from the endpoints and the parameters at
+ * which the tangent is perpendicular to an axis. It is usually smaller than the box of the control points.
+ * For example:
+ *   BezierCurve2[float64]{{0, 0}, {1, 2}, {2, 0}}.Bounds() returns AABB2[float64]{Min: {0, 0}, Max: {2, 1}}
+ */
+func (c BezierCurve2[T]) Bounds() AABB2[T] {
+	d := c.Derivative()
+	xs, ys := make([]float64, len(d)), make([]float64, len(d))
+	for i, p := range d {
+		xs[i], ys[i] = float64(p.X), float64(p.Y)
+	}
+	points := []Vec2[T]{c.At(0), c.At(1)}
+	for _, t := range append(bernsteinRoots(xs), bernsteinRoots(ys)...) {
+		points = append(points, c.At(T(t)))
+	}
+	return AABB2FromPoints(points...)
+}
+
+/**
+ * ClosestPoint returns the parameter and point of the curve nearest to p. The curve is sampled to bracket each local
+ * minimum of the distance, which Newton's method then refines.
+ * For example:
+ *   BezierCurve2[float64]{{0, 0}, {1, 2}, {2, 0}}.ClosestPoint(Vec2[float64]{1, 3}) returns 0.5 and Vec2[float64]{1, 1}
+ */
+func (c BezierCurve2[T]) ClosestPoint(p Vec2[T]) (T, Vec2[T]) {
+	return bezierClosest([]Vec2[T](c), p)
+}
+
+/**
+ * Degree returns the degree of the curve, one less than the number of control points.
+ * For example:
+ *   BezierCurve3[float64]{{0, 0, 0}, {1, 1, 1}}.Degree() returns 1
+ */
+func (c BezierCurve3[T]) Degree() int {
+	return len(c) - 1
+}
+
+/**
+ * At returns the point of the curve at parameter t by de Casteljau's algorithm. It panics if the curve has no control
+ * points.
+ * For example:
+ *   BezierCurve3[float64]{{0, 0, 0}, {1, 2, 4}, {2, 0, 0}}.At(0.5) returns Vec3[float64]{1, 1, 2}
+ */
+func (c BezierCurve3[T]) At(t T) Vec3[T] {
+	return bezierAt([]Vec3[T](c), t)
+}
+
+/**
+ * Derivative returns the hodograph of the curve, the Bézier curve of one lower degree whose value at t is the tangent
+ * of c at t. The derivative of a single point is the zero curve.
+ * For example:
+ *   BezierCurve3[float64]{{0, 0, 0}, {1, 2, 4}, {2, 0, 0}}.Derivative() returns BezierCurve3[float64]{{2, 4, 8}, {2, -4, -8}}
+ */
+func (c BezierCurve3[T]) Derivative() BezierCurve3[T] {
+	return bezierDerivative([]Vec3[T](c))
+}
+
+/**
+ * Split divides the curve at parameter t into two curves of the same degree. The first covers [0, t] and the second
+ * [t, 1], each reparameterized over [0, 1].
+ * For example:
+ *   BezierCurve3[float64]{{0, 0, 0}, {2, 2, 2}}.Split(0.25) returns {{0, 0, 0}, {0.5, 0.5, 0.5}} and {{0.5, 0.5, 0.5}, {2, 2, 2}}
+ */
+func (c BezierCurve3[T]) Split(t T) (BezierCurve3[T], BezierCurve3[T]) {
+	left, right := bezierSplit([]Vec3[T](c), t)
+	return left, right
+}
+
+/**
+ * Elevate returns the same curve expressed with one more control point.
+ * For example:
+ *   BezierCurve3[float64]{{0, 0, 0}, {2, 2, 2}}.Elevate() returns BezierCurve3[float64]{{0, 0, 0}, {1, 1, 1}, {2, 2, 2}}
+ */
+func (c BezierCurve3[T]) Elevate() BezierCurve3[T] {
+	return bezierElevate([]Vec3[T](c))
+}
+
+/**
+ * Bounds returns the tightest axis-aligned box containing the curve, found from the endpoints and the parameters at
+ * which the tangent is perpendicular to an axis. It is usually smaller than the box of the control points.
+ * For example:
+ *   BezierCurve3[float64]{{0, 0, 0}, {1, 2, 4}, {2, 0, 0}}.Bounds() returns AABB3[float64]{Min: {0, 0, 0}, Max: {2, 1, 2}}
+ */
+func (c BezierCurve3[T]) Bounds() AABB3[T] {
+	d := c.Derivative()
+	xs, ys, zs := make([]float64, len(d)), make([]float64, len(d)), make([]float64, len(d))
+	for i, p := range d {
+		xs[i], ys[i], zs[i] = float64(p.X), float64(p.Y), float64(p.Z)
+	}
+	points := []Vec3[T]{c.At(0), c.At(1)}
+	for _, roots := range [][]float64{bernsteinRoots(xs), bernsteinRoots(ys), bernsteinRoots(zs)} {
+		for _, t := range roots {
+			points = append(points, c.At(T(t)))
+		}
+	}
+	return AABB3FromPoints(points...)
+}
+
+/**
+ * ClosestPoint returns the parameter and point of the curve nearest to p. The curve is sampled to bracket each local
+ * minimum of the distance, which Newton's method then refines.
+ * For example:
+ *   BezierCurve3[float64]{{0, 0, 0}, {1, 2, 0}, {2, 0, 0}}.ClosestPoint(Vec3[float64]{1, 3, 0}) returns 0.5 and Vec3[float64]{1, 1, 0}
+ */
+func (c BezierCurve3[T]) ClosestPoint(p Vec3[T]) (T, Vec3[T]) {
+	return bezierClosest([]Vec3[T](c), p)
+}
+
+// mustControlPoints panics if a curve has no control points.
+func mustControlPoints[V any](points []V) {
+	if len(points) == 0 {
+		panic("bm: Bézier curve has no control points")
+	}
+}
+
+// bezierAt evaluates the curve with the given control points at t by de Casteljau's algorithm.
+func bezierAt[V CurvePoint[V, T], T Numeric](points []V, t T) V {
+	mustControlPoints(points)
+	work := make([]V, len(points))
+	copy(work, points)
+	for n := len(work) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			work[i] = work[i].Lerp(work[i+1], t)
+		}
+	}
+	return work[0]
+}
+
+// bezierDerivative returns the control points of the hodograph, n(P[i+1] - P[i]) for a curve of degree n.
+func bezierDerivative[V CurvePoint[V, T], T Numeric](points []V) []V {
+	mustControlPoints(points)
+	n := len(points) - 1
+	if n == 0 {
+		var zero V
+		return []V{zero}
+	}
+	d := make([]V, n)
+	for i := range d {
+		d[i] = points[i+1].Sub(points[i]).Scale(T(n))
+	}
+	return d
+}
+
+// bezierSplit subdivides the curve at t. The left curve is made of the first points of each de Casteljau level and the
+// right curve of the last points.
+func bezierSplit[V CurvePoint[V, T], T Numeric](points []V, t T) ([]V, []V) {
+	mustControlPoints(points)
+	n := len(points)
+	work := make([]V, n)
+	copy(work, points)
+	left, right := make([]V, n), make([]V, n)
+	for level := 0; level < n; level++ {
+		left[level], right[n-1-level] = work[0], work[n-1-level]
+		for i := 0; i < n-1-level; i++ {
+			work[i] = work[i].Lerp(work[i+1], t)
+		}
+	}
+	return left, right
+}
+
+// bezierElevate raises the degree by one: Q[i] = (i P[i-1] + (n+1-i) P[i]) / (n+1).
+func bezierElevate[V CurvePoint[V, T], T Numeric](points []V) []V {
+	mustControlPoints(points)
+	n := len(points) - 1
+	q := make([]V, n+2)
+	q[0], q[n+1] = points[0], points[n]
+	for i := 1; i <= n; i++ {
+		q[i] = points[i-1].Scale(T(i)).Add(points[i].Scale(T(n + 1 - i))).ScalarDiv(T(n + 1))
+	}
+	return q
+}
+
+// bezierClosest minimizes |B(t) - p|² over [0, 1]. The distance is sampled at enough parameters to separate the local
+// minima of a curve of this degree, and each sampled minimum is refined by Newton's method on B'(t)·(B(t) - p).
+func bezierClosest[V CurvePoint[V, T], T Numeric](points []V, p V) (T, V) {
+	mustControlPoints(points)
+	d := bezierDerivative(points)
+	dd := bezierDerivative(d)
+	samples := 8 * len(points)
+
+	dist := make([]float64, samples+1)
+	for k := range dist {
+		q := bezierAt(points, T(k)/T(samples)).Sub(p)
+		dist[k] = float64(q.Dot(q))
+	}
+	bestT, best := T(0), math.Inf(1)
+	for k := range dist {
+		if (k > 0 && dist[k-1] < dist[k]) || (k < samples && dist[k+1] < dist[k]) {
+			continue
+		}
+		t := T(k) / T(samples)
+		lo, hi := T(max(k-1, 0))/T(samples), T(min(k+1, samples))/T(samples)
+		for i := 0; i < bezierNewtonIterations; i++ {
+			q, tangent := bezierAt(points, t).Sub(p), bezierAt(d, t)
+			slope := float64(bezierAt(dd, t).Dot(q) + tangent.Dot(tangent))
+			if slope <= 0 {
+				break
+			}
+			next := Clamp(t-T(float64(tangent.Dot(q))/slope), lo, hi)
+			step := float64(next - t)
+			t = next
+			if math.Abs(step) < bezierRootTolerance {
+				break
+			}
+		}
+		q := bezierAt(points, t).Sub(p)
+		if dt := float64(q.Dot(q)); dt < best {
+			bestT, best = t, dt
+		}
+	}
+	return bestT, bezierAt(points, bestT)
+}
+
+// bernsteinRoots returns the roots in [0, 1] of the polynomial with the given Bernstein coefficients. An interval is
+// discarded when its coefficients share a sign, since the polynomial lies in their convex hull, and is otherwise
+// halved until it is narrower than bezierRootTolerance. A polynomial that is identically zero has no reported roots.
+func bernsteinRoots(coefs []float64) []float64 {
+	var roots []float64
+	var search func(c []float64, lo, hi float64)
+	search = func(c []float64, lo, hi float64) {
+		low, high := math.Inf(1), math.Inf(-1)
+		for _, v := range c {
+			low, high = math.Min(low, v), math.Max(high, v)
+		}
+		if low > 0 || high < 0 || (low == 0 && high == 0) {
+			return
+		}
+		mid := (lo + hi) / 2
+		if hi-lo < bezierRootTolerance {
+			roots = append(roots, mid)
+			return
+		}
+		// Halve the interval by de Casteljau's algorithm at 0.5.
+		n := len(c)
+		work, left, right := append([]float64(nil), c...), make([]float64, n), make([]float64, n)
+		for level := 0; level < n; level++ {
+			left[level], right[n-1-level] = work[0], work[n-1-level]
+			for i := 0; i < n-1-level; i++ {
+				work[i] = (work[i] + work[i+1]) / 2
+			}
+		}
+		search(left, lo, mid)
+		search(right, mid, hi)
+	}
+	search(coefs, 0, 1)
+	return roots
+}
@@ -0,0 +1,127 @@
+package bm
+
+import (
+	"math"
+	"testing"
+)
+
+// TestBezierCurveAt tests evaluation against the Bernstein form and the scalar Bezier3.
+func TestBezierCurveAt(t *testing.T) {
+	c := BezierCurve2[float64]{{0, 0}, {1, 3}, {3, 3}, {4, 0}}
+	for _, v := range []float64{0, 0.2, 0.5, 0.9, 1} {
+		want := Vec2[float64]{Bezier3(0.0, 1, 3, 4, v), Bezier3(0.0, 3, 3, 0, v)}
+		if got := c.At(v); !approxEqual(got.X, want.X, 1e-12) || !approxEqual(got.Y, want.Y, 1e-12) {
+			t.Errorf("At(%v) = %v, want %v", v, got, want)
+		}
+	}
+
+	// A quartic checked against the Bernstein polynomials.
+	q := BezierCurve3[float64]{{0, 0, 0}, {1, 2, -1}, {2, -1, 3}, {4, 1, 0}, {5, 5, 5}}
+	v := 0.3
+	var want Vec3[float64]
+	for i, p := range q {
+		b := []float64{1, 4, 6, 4, 1}[i] * math.Pow(v, float64(i)) * math.Pow(1-v, float64(4-i))
+		want = want.Add(p.Scale(b))
+	}
+	if got := q.At(v); !vec3ApproxEqual(got, want, 1e-12) {
+		t.Errorf("quartic At(%v) = %v, want %v", v, got, want)
+	}
+	if got := q.Degree(); got != 4 {
+		t.Errorf("Degree() = %d, want 4", got)
+	}
+	if got := NewBezierCurve2(Vec2[float64]{1, 2}).At(0.7); got != (Vec2[float64]{1, 2}) {
+		t.Errorf("single point At(0.7) = %v, want {1 2}", got)
+	}
+}
+
+// TestBezierCurveDerivative tests the hodograph against central differences.
+func TestBezierCurveDerivative(t *testing.T) {
+	c := BezierCurve3[float64]{{0, 0, 0}, {1, 2, -1}, {2, -1, 3}, {4, 1, 0}}
+	d := c.Derivative()
+	if got := d.Degree(); got != 2 {
+		t.Errorf("Derivative().Degree() = %d, want 2", got)
+	}
+	const h = 1e-6
+	for _, v := range []float64{0.1, 0.4, 0.75} {
+		want := c.At(v + h).Sub(c.At(v - h)).Scale(1 / (2 * h))
+		if got := d.At(v); !vec3ApproxEqual(got, want, 1e-6) {
+			t.Errorf("Derivative().At(%v) = %v, want %v", v, got, want)
+		}
+	}
+	if got := NewBezierCurve2(Vec2[float64]{3, 4}).Derivative(); len(got) != 1 || got[0] != (Vec2[float64]{}) {
+		t.Errorf("single point Derivative() = %v, want the zero curve", got)
+	}
+}
+
+// TestBezierCurveSplitAndElevate tests that splitting and degree elevation leave the curve unchanged.
+func TestBezierCurveSplitAndElevate(t *testing.T) {
+	c := BezierCurve2[float64]{{0, 0}, {1, 3}, {3, -2}, {4, 1}, {6, 0}}
+	const at = 0.3
+	left, right := c.Split(at)
+	elevated := c.Elevate()
+	if len(left) != len(c) || len(right) != len(c) || elevated.Degree() != c.Degree()+1 {
+		t.Fatalf("Split() and Elevate() degrees = (%d, %d, %d), want (4, 4, 5)", left.Degree(), right.Degree(), elevated.Degree())
+	}
+	for _, v := range []float64{0, 0.25, 0.5, 0.8, 1} {
+		if got, want := left.At(v), c.At(at*v); got.Dist(want) > 1e-12 {
+			t.Errorf("left.At(%v) = %v, want %v", v, got, want)
+		}
+		if got, want := right.At(v), c.At(at+(1-at)*v); got.Dist(want) > 1e-12 {
+			t.Errorf("right.At(%v) = %v, want %v", v, got, want)
+		}
+		if got, want := elevated.At(v), c.At(v); got.Dist(want) > 1e-12 {
+			t.Errorf("Elevate().At(%v) = %v, want %v", v, got, want)
+		}
+	}
+	if got := (BezierCurve2[float64]{{0, 0}, {2, 2}}).Elevate(); len(got) != 3 || got[1] != (Vec2[float64]{1, 1}) {
+		t.Errorf("line Elevate() = %v, want {{0 0} {1 1} {2 2}}", got)
+	}
+}
+
+// TestBezierCurveBounds tests that the bounds are tight and contain every sampled point.
+func TestBezierCurveBounds(t *testing.T) {
+	quad := BezierCurve2[float64]{{0, 0}, {1, 2}, {2, 0}}
+	if got := quad.Bounds(); !approxEqual(got.Min.X, 0, 1e-12) || !approxEqual(got.Min.Y, 0, 1e-12) ||
+		!approxEqual(got.Max.X, 2, 1e-12) || !approxEqual(got.Max.Y, 1, 1e-12) {
+		t.Errorf("quadratic Bounds() = %v, want {{0 0} {2 1}}", got)
+	}
+
+	c := BezierCurve3[float64]{{0, 0, 0}, {-2, 3, 1}, {4, 3, -2}, {2, -1, 0}, {1, 0, 2}}
+	box := c.Bounds()
+	sampled := AABB3FromPoints(c[0])
+	for k := 0; k <= 10000; k++ {
+		p := c.At(float64(k) / 10000)
+		sampled = sampled.ExpandToPoint(p)
+		grown := box.Expand(1e-9)
+		if !grown.Contains(p) {
+			t.Errorf("Bounds() = %v does not contain At(%v) = %v", box, float64(k)/10000, p)
+		}
+	}
+	if !vec3ApproxEqual(box.Min, sampled.Min, 1e-6) || !vec3ApproxEqual(box.Max, sampled.Max, 1e-6) {
+		t.Errorf("Bounds() = %v, want about %v", box, sampled)
+	}
+}
+
+// TestBezierCurveClosestPoint tests closest points against brute force sampling.
+func TestBezierCurveClosestPoint(t *testing.T) {
+	quad := BezierCurve2[float64]{{0, 0}, {1, 2}, {2, 0}}
+	if tt, p := quad.ClosestPoint(Vec2[float64]{1, 3}); !approxEqual(tt, 0.5, 1e-9) || p.Dist(Vec2[float64]{1, 1}) > 1e-9 {
+		t.Errorf("ClosestPoint({1 3}) = (%v, %v), want (0.5, {1 1})", tt, p)
+	}
+	if tt, p := quad.ClosestPoint(Vec2[float64]{-1, -1}); tt != 0 || p != quad[0] {
+		t.Errorf("ClosestPoint({-1 -1}) = (%v, %v), want (0, {0 0})", tt, p)
+	}
+
+	// An S-shaped cubic has several local minima for points between its lobes.
+	c := BezierCurve2[float64]{{0, 0}, {3, 4}, {-1, 4}, {2, 0}}
+	for _, q := range []Vec2[float64]{{1, 2}, {0.5, 3.5}, {3, 1}, {-1, 2}, {1, -1}} {
+		best := math.Inf(1)
+		for k := 0; k <= 100000; k++ {
+			best = math.Min(best, c.At(float64(k)/100000).Dist(q))
+		}
+		tt, p := c.ClosestPoint(q)
+		if tt < 0 || tt > 1 || p.Dist(c.At(tt)) > 1e-12 || p.Dist(q) > best+1e-9 {
+			t.Errorf("ClosestPoint(%v) = (%v, %v) at distance %v, want distance %v", q, tt, p, p.Dist(q), best)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package bm
+
+// Capsule represents the set of points within Radius of the line segment from A to B.
+type Capsule[T Numeric] struct {
+	A, B   Vec3[T]
+	Radius T
+}
+
+/**
+ * NewCapsule returns the capsule around the segment from a to b with the given radius.
+ * For example:
+ *   NewCapsule(Vec3[float64]{0, 0, 0}, Vec3[float64]{0, 2, 0}, 0.5) returns an upright capsule
+ */
+func NewCapsule[T Numeric](a, b Vec3[T], radius T) Capsule[T] {
+	return Capsule[T]{A: a, B: b, Radius: radius}
+}
+
+/**
+ * Segment returns the core segment of the capsule.
+ * For example:
+ *   NewCapsule(Vec3[float64]{0, 0, 0}, Vec3[float64]{0, 2, 0}, 0.5).Segment() returns NewLineSegment(Vec3[float64]{0, 0, 0}, Vec3[float64]{0, 2, 0})
+ */
+func (c Capsule[T]) Segment() LineSegment[T] {
+	return LineSegment[T]{A: c.A, B: c.B}
+}
+
+/**
+ * Contains reports whether the point p lies inside or on the capsule.
+ * For example:
+ *   NewCapsule(Vec3[float64]{0, 0, 0}, Vec3[float64]{0, 2, 0}, 0.5).Contains(Vec3[float64]{0.5, 1, 0}) returns true
+ */
+func (c Capsule[T]) Contains(p Vec3[T]) bool {
+	d := p.Sub(c.Segment().ClosestPoint(p))
+	return d.Dot(d) <= c.Radius*c.Radius
+}
+
+/**
+ * Distance returns the signed distance from the surface of the capsule to p, negative inside the capsule.
+ * For example:
+ *   NewCapsule(Vec3[float64]{0, 0, 0}, Vec3[float64]{0, 2, 0}, 0.5).Distance(Vec3[float64]{0, 4, 0}) returns 1.5
+ */
+func (c Capsule[T]) Distance(p Vec3[T]) T {
+	return c.Segment().Distance(p) - c.Radius
+}
+
+/**
+ * Bounds returns the axis-aligned box enclosing the capsule.
+ * For example:
+ *   NewCapsule(Vec3[float64]{0, 0, 0}, Vec3[float64]{0, 2, 0}, 0.5).Bounds() returns AABB3[float64]{Min: {-0.5, -0.5, -0.5}, Max: {0.5, 2.5, 0.5}}
+ */
+func (c Capsule[T]) Bounds() AABB3[T] {
+	return NewAABB3(c.A, c.B).Expand(c.Radius)
+}
+
+/**
+ * OverlapsSphere reports whether the capsule intersects or touches the sphere.
+ * For example:
+ *   NewCapsule(Vec3[float64]{-1, 0, 0}, Vec3[float64]{1, 0, 0}, 1).OverlapsSphere(NewSphere(Vec3[float64]{0, 2, 0}, 1)) returns true
+ */
+func (c Capsule[T]) OverlapsSphere(s Sphere[T]) bool {
+	d := s.Center.Sub(c.Segment().ClosestPoint(s.Center))
+	r := c.Radius + s.Radius
+	return d.Dot(d) <= r*r
+}
+
+/**
+ * Overlaps reports whether the two capsules intersect or touch.
+ * For example:
+ *   NewCapsule(Vec3[float64]{-1, 0, 0}, Vec3[float64]{1, 0, 0}, 1).Overlaps(NewCapsule(Vec3[float64]{0, -1, 2}, Vec3[float64]{0, 1, 2}, 1)) returns true
+ */
+func (c Capsule[T]) Overlaps(other Capsule[T]) bool {
+	p, q := c.Segment().ClosestPoints(other.Segment())
+	d := q.Sub(p)
+	r := c.Radius + other.Radius
+	return d.Dot(d) <= r*r
+}
+
+/**
+ * Support returns the point of the capsule farthest along dir, so a Capsule can be used with GJK and EPA.
+ * For example:
+ *   NewCapsule(Vec3[float64]{0, 0, 0}, Vec3[float64]{0, 2, 0}, 0.5).Support(Vec3[float64]{0, 1, 0}) returns Vec3[float64]{0, 2.5, 0}
+ */
+func (c Capsule[T]) Support(dir Vec3[T]) Vec3[T] {
+	end := c.A
+	if c.B.Dot(dir) > c.A.Dot(dir) {
+		end = c.B
+	}
+	return end.Add(dir.Norm().Scale(c.Radius))
+}
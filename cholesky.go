@@ -0,0 +1,345 @@
+package bm
+
+// Cholesky is the Cholesky factorization A = L*Lᵀ of a symmetric positive-definite matrix, with L lower triangular.
+// Factorizations are intended for floating-point types.
+type Cholesky[T Numeric] struct {
+	l Dense[T]
+}
+
+// LDL is the factorization A = L*D*Lᵀ of a symmetric positive-definite matrix, with L unit lower triangular and D
+// diagonal. Unlike Cholesky it needs no square roots. Factorizations are intended for floating-point types.
+type LDL[T Numeric] struct {
+	l Dense[T]
+	d Vector[T]
+}
+
+/**
+ * Cholesky returns the Cholesky factorization of the symmetric matrix, reading only its lower triangle.
+ * It returns ErrNotPositiveDefinite if the matrix is not positive definite and panics if it is not square.
+ * For example:
+ *   NewDense(2, 2, []float64{4, 2, 2, 5}).Cholesky() returns a factorization whose L() is the matrix [[2, 0], [1, 2]]
+ */
+func (d Dense[T]) Cholesky() (Cholesky[T], error) {
+	d.mustSquare()
+	n := d.rows
+	l := NewDense[T](n, n, nil)
+	for j := 0; j < n; j++ {
+		sum := d.data[j*n+j]
+		for k := 0; k < j; k++ {
+			sum -= l.data[j*n+k] * l.data[j*n+k]
+		}
+		if sum <= 0 {
+			return Cholesky[T]{}, ErrNotPositiveDefinite
+		}
+		ljj := Sqrt(sum)
+		l.data[j*n+j] = ljj
+		for i := j + 1; i < n; i++ {
+			s := d.data[i*n+j]
+			for k := 0; k < j; k++ {
+				s -= l.data[i*n+k] * l.data[j*n+k]
+			}
+			l.data[i*n+j] = s / ljj
+		}
+	}
+	return Cholesky[T]{l: l}, nil
+}
+
+/**
+ * Cholesky returns the Cholesky factorization of the symmetric matrix, or ErrNotPositiveDefinite.
+ * For example:
+ *   IdentityMat3[float64]().Cholesky() returns a factorization whose L() is the 3x3 identity
+ */
+func (m Mat3[T]) Cholesky() (Cholesky[T], error) {
+	return DenseFromMat3(m).Cholesky()
+}
+
+/**
+ * Cholesky returns the Cholesky factorization of the symmetric matrix, or ErrNotPositiveDefinite.
+ * For example:
+ *   ScaleMat4(Vec3[float64]{4, 9, 16}).Cholesky() returns a factorization whose L() is ScaleMat4(Vec3[float64]{2, 3, 4})
+ */
+func (m Mat4[T]) Cholesky() (Cholesky[T], error) {
+	return DenseFromMat4(m).Cholesky()
+}
+
+/**
+ * L returns the lower triangular factor.
+ * For example:
+ *   c.L().Mul(c.L().Transpose()) returns the factorized matrix
+ */
+func (c Cholesky[T]) L() Dense[T] {
+	return c.l.Clone()
+}
+
+/**
+ * Determinant returns the determinant of the factorized matrix, the square of the product of L's diagonal.
+ * For example:
+ *   NewDense(2, 2, []float64{4, 2, 2, 5}).Cholesky() gives a factorization whose Determinant() is 16
+ */
+func (c Cholesky[T]) Determinant() T {
+	n := c.l.rows
+	var det T = 1
+	for i := 0; i < n; i++ {
+		det *= c.l.data[i*n+i]
+	}
+	return det * det
+}
+
+/**
+ * Solve returns the solution x of A * x = b. It panics if b has the wrong length.
+ * For example:
+ *   c.Solve(a.MulVec(x)) returns x up to rounding
+ */
+func (c Cholesky[T]) Solve(b Vector[T]) Vector[T] {
+	n := c.l.rows
+	b.mustLen(n)
+	x := b.Clone()
+	l := c.l.data
+	for i := 0; i < n; i++ {
+		for k := 0; k < i; k++ {
+			x[i] -= l[i*n+k] * x[k]
+		}
+		x[i] /= l[i*n+i]
+	}
+	for i := n - 1; i >= 0; i-- {
+		for k := i + 1; k < n; k++ {
+			x[i] -= l[k*n+i] * x[k]
+		}
+		x[i] /= l[i*n+i]
+	}
+	return x
+}
+
+/**
+ * SolveDense returns the solution X of A * X = B for every column of B. It panics if B has the wrong number of rows.
+ * For example:
+ *   c.SolveDense(IdentityDense[float64](n)) returns the inverse of the factorized matrix
+ */
+func (c Cholesky[T]) SolveDense(b Dense[T]) Dense[T] {
+	return solveColumns(b, c.l.rows, c.Solve)
+}
+
+/**
+ * Update returns the factorization of A + x*xᵀ, computed in O(n²) without refactorizing. It panics if x has the wrong length.
+ * For example:
+ *   c.Update(x) gives the same L() as the Cholesky factorization of a.Add(x*xᵀ)
+ */
+func (c Cholesky[T]) Update(x Vector[T]) Cholesky[T] {
+	out, _ := c.rankOne(x, false)
+	return out
+}
+
+/**
+ * Downdate returns the factorization of A - x*xᵀ, computed in O(n²) without refactorizing, or ErrNotPositiveDefinite
+ * if the result is not positive definite. It panics if x has the wrong length.
+ * For example:
+ *   c.Update(x).Downdate(x) returns c up to rounding
+ */
+func (c Cholesky[T]) Downdate(x Vector[T]) (Cholesky[T], error) {
+	return c.rankOne(x, true)
+}
+
+// rankOne applies the rotations of a rank-one update or downdate to a copy of the factor.
+func (c Cholesky[T]) rankOne(x Vector[T], downdate bool) (Cholesky[T], error) {
+	n := c.l.rows
+	x.mustLen(n)
+	w := x.Clone()
+	l := c.l.Clone()
+	for k := 0; k < n; k++ {
+		lkk := l.data[k*n+k]
+		r2 := lkk*lkk + w[k]*w[k]
+		if downdate {
+			r2 = lkk*lkk - w[k]*w[k]
+		}
+		if r2 <= 0 {
+			return Cholesky[T]{}, ErrNotPositiveDefinite
+		}
+		r := Sqrt(r2)
+		cos := r / lkk
+		sin := w[k] / lkk
+		l.data[k*n+k] = r
+		for i := k + 1; i < n; i++ {
+			if downdate {
+				l.data[i*n+k] = (l.data[i*n+k] - sin*w[i]) / cos
+			} else {
+				l.data[i*n+k] = (l.data[i*n+k] + sin*w[i]) / cos
+			}
+			w[i] = cos*w[i] - sin*l.data[i*n+k]
+		}
+	}
+	return Cholesky[T]{l: l}, nil
+}
+
+/**
+ * LDL returns the L*D*Lᵀ factorization of the symmetric matrix, reading only its lower triangle.
+ * It returns ErrNotPositiveDefinite if a diagonal entry of D is not positive and panics if the matrix is not square.
+ * For example:
+ *   NewDense(2, 2, []float64{4, 2, 2, 5}).LDL() returns a factorization with L() [[1, 0], [0.5, 1]] and D() [4, 4]
+ */
+func (d Dense[T]) LDL() (LDL[T], error) {
+	d.mustSquare()
+	n := d.rows
+	f := LDL[T]{l: IdentityDense[T](n), d: make(Vector[T], n)}
+	l := f.l.data
+	for j := 0; j < n; j++ {
+		dj := d.data[j*n+j]
+		for k := 0; k < j; k++ {
+			dj -= l[j*n+k] * l[j*n+k] * f.d[k]
+		}
+		if dj <= 0 {
+			return LDL[T]{}, ErrNotPositiveDefinite
+		}
+		f.d[j] = dj
+		for i := j + 1; i < n; i++ {
+			s := d.data[i*n+j]
+			for k := 0; k < j; k++ {
+				s -= l[i*n+k] * l[j*n+k] * f.d[k]
+			}
+			l[i*n+j] = s / dj
+		}
+	}
+	return f, nil
+}
+
+/**
+ * LDL returns the L*D*Lᵀ factorization of the symmetric matrix, or ErrNotPositiveDefinite.
+ * For example:
+ *   IdentityMat3[float64]().LDL() returns a factorization with D() [1, 1, 1]
+ */
+func (m Mat3[T]) LDL() (LDL[T], error) {
+	return DenseFromMat3(m).LDL()
+}
+
+/**
+ * LDL returns the L*D*Lᵀ factorization of the symmetric matrix, or ErrNotPositiveDefinite.
+ * For example:
+ *   IdentityMat4[float64]().LDL() returns a factorization with D() [1, 1, 1, 1]
+ */
+func (m Mat4[T]) LDL() (LDL[T], error) {
+	return DenseFromMat4(m).LDL()
+}
+
+/**
+ * L returns the unit lower triangular factor.
+ * For example:
+ *   NewDense(2, 2, []float64{4, 2, 2, 5}).LDL() gives a factorization whose L() is the matrix [[1, 0], [0.5, 1]]
+ */
+func (f LDL[T]) L() Dense[T] {
+	return f.l.Clone()
+}
+
+/**
+ * D returns the diagonal of the diagonal factor.
+ * For example:
+ *   NewDense(2, 2, []float64{4, 2, 2, 5}).LDL() gives a factorization whose D() is Vector[float64]{4, 4}
+ */
+func (f LDL[T]) D() Vector[T] {
+	return f.d.Clone()
+}
+
+/**
+ * Determinant returns the determinant of the factorized matrix, the product of D.
+ * For example:
+ *   NewDense(2, 2, []float64{4, 2, 2, 5}).LDL() gives a factorization whose Determinant() is 16
+ */
+func (f LDL[T]) Determinant() T {
+	var det T = 1
+	for _, x := range f.d {
+		det *= x
+	}
+	return det
+}
+
+/**
+ * Solve returns the solution x of A * x = b. It panics if b has the wrong length.
+ * For example:
+ *   f.Solve(a.MulVec(x)) returns x up to rounding
+ */
+func (f LDL[T]) Solve(b Vector[T]) Vector[T] {
+	n := len(f.d)
+	b.mustLen(n)
+	x := b.Clone()
+	l := f.l.data
+	for i := 0; i < n; i++ {
+		for k := 0; k < i; k++ {
+			x[i] -= l[i*n+k] * x[k]
+		}
+	}
+	for i := range x {
+		x[i] /= f.d[i]
+	}
+	for i := n - 1; i >= 0; i-- {
+		for k := i + 1; k < n; k++ {
+			x[i] -= l[k*n+i] * x[k]
+		}
+	}
+	return x
+}
+
+/**
+ * SolveDense returns the solution X of A * X = B for every column of B. It panics if B has the wrong number of rows.
+ * For example:
+ *   f.SolveDense(IdentityDense[float64](n)) returns the inverse of the factorized matrix
+ */
+func (f LDL[T]) SolveDense(b Dense[T]) Dense[T] {
+	return solveColumns(b, len(f.d), f.Solve)
+}
+
+/**
+ * Update returns the factorization of A + x*xᵀ, computed in O(n²) without refactorizing. It panics if x has the wrong length.
+ * For example:
+ *   f.Update(x) gives the same L() and D() as the LDL factorization of a.Add(x*xᵀ)
+ */
+func (f LDL[T]) Update(x Vector[T]) LDL[T] {
+	out, _ := f.rankOne(x, 1)
+	return out
+}
+
+/**
+ * Downdate returns the factorization of A - x*xᵀ, computed in O(n²) without refactorizing, or ErrNotPositiveDefinite
+ * if the result is not positive definite. It panics if x has the wrong length.
+ * For example:
+ *   f.Update(x).Downdate(x) returns f up to rounding
+ */
+func (f LDL[T]) Downdate(x Vector[T]) (LDL[T], error) {
+	var one T = 1
+	return f.rankOne(x, -one)
+}
+
+// rankOne computes the factorization of A + sigma*x*xᵀ with method C1 of Gill, Golub, Murray and Saunders.
+func (f LDL[T]) rankOne(x Vector[T], sigma T) (LDL[T], error) {
+	n := len(f.d)
+	x.mustLen(n)
+	w := x.Clone()
+	out := LDL[T]{l: f.l.Clone(), d: f.d.Clone()}
+	l := out.l.data
+	alpha := sigma
+	for j := 0; j < n; j++ {
+		p := w[j]
+		dj := out.d[j] + alpha*p*p
+		if dj <= 0 {
+			return LDL[T]{}, ErrNotPositiveDefinite
+		}
+		beta := p * alpha / dj
+		alpha = out.d[j] * alpha / dj
+		out.d[j] = dj
+		for i := j + 1; i < n; i++ {
+			w[i] -= p * l[i*n+j]
+			l[i*n+j] += beta * w[i]
+		}
+	}
+	return out, nil
+}
+
+// solveColumns applies solve to every column of the n-row matrix b.
+func solveColumns[T Numeric](b Dense[T], n int, solve func(Vector[T]) Vector[T]) Dense[T] {
+	b.mustDims(n, b.cols)
+	x := NewDense[T](n, b.cols, nil)
+	for j := 0; j < b.cols; j++ {
+		col := solve(b.Col(j))
+		for i := 0; i < n; i++ {
+			x.data[i*b.cols+j] = col[i]
+		}
+	}
+	return x
+}
@@ -0,0 +1,156 @@
+package bm
+
+import (
+	"errors"
+	"testing"
+)
+
+// spdDense returns the symmetric positive-definite matrix Bᵀ*B + I for a fixed n x n matrix B.
+func spdDense(n int) Dense[float64] {
+	b := NewDense[float64](n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			b.Set(i, j, float64((i*7+j*3)%5)-2)
+		}
+	}
+	return b.Transpose().Mul(b).Add(IdentityDense[float64](n))
+}
+
+// outer returns x*xᵀ.
+func outer(x Vector[float64]) Dense[float64] {
+	col := NewDense(len(x), 1, x.Clone())
+	return col.Mul(col.Transpose())
+}
+
+// TestCholesky tests factorization, solving and determinants against LU.
+func TestCholesky(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Dense[float64]
+	}{
+		{"2x2", NewDense(2, 2, []float64{4, 2, 2, 5})},
+		{"diagonal", DenseFromMat4(ScaleMat4(Vec3[float64]{4, 9, 16}))},
+		{"5x5", spdDense(5)},
+	}
+	for _, tt := range tests {
+		n, _ := tt.a.Dims()
+		c, err := tt.a.Cholesky()
+		if err != nil {
+			t.Fatalf("%s: Cholesky() error = %v", tt.name, err)
+		}
+		l := c.L()
+		if got := l.Mul(l.Transpose()); !denseApproxEqual(got, tt.a, 1e-12) {
+			t.Errorf("%s: L*Lᵀ = %v, want %v", tt.name, got, tt.a)
+		}
+		if want := tt.a.Determinant(); !approxEqual(c.Determinant(), want, 1e-9) {
+			t.Errorf("%s: Determinant() = %v, want %v", tt.name, c.Determinant(), want)
+		}
+		x := NewVector[float64](n)
+		for i := range x {
+			x[i] = float64(i) - 1.5
+		}
+		if got := c.Solve(tt.a.MulVec(x)); got.Sub(x).Mag() > 1e-12 {
+			t.Errorf("%s: Solve() = %v, want %v", tt.name, got, x)
+		}
+		if got := tt.a.Mul(c.SolveDense(IdentityDense[float64](n))); !denseApproxEqual(got, IdentityDense[float64](n), 1e-12) {
+			t.Errorf("%s: A*SolveDense(I) = %v, want identity", tt.name, got)
+		}
+
+		f, err := tt.a.LDL()
+		if err != nil {
+			t.Fatalf("%s: LDL() error = %v", tt.name, err)
+		}
+		d := NewDense[float64](n, n, nil)
+		for i, v := range f.D() {
+			d.Set(i, i, v)
+		}
+		if got := f.L().Mul(d).Mul(f.L().Transpose()); !denseApproxEqual(got, tt.a, 1e-12) {
+			t.Errorf("%s: L*D*Lᵀ = %v, want %v", tt.name, got, tt.a)
+		}
+		if !approxEqual(f.Determinant(), c.Determinant(), 1e-9) {
+			t.Errorf("%s: LDL Determinant() = %v, want %v", tt.name, f.Determinant(), c.Determinant())
+		}
+		if got := f.Solve(tt.a.MulVec(x)); got.Sub(x).Mag() > 1e-12 {
+			t.Errorf("%s: LDL Solve() = %v, want %v", tt.name, got, x)
+		}
+	}
+}
+
+// TestCholeskyNotPositiveDefinite tests that indefinite and singular matrices are rejected.
+func TestCholeskyNotPositiveDefinite(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Dense[float64]
+	}{
+		{"indefinite", NewDense(2, 2, []float64{1, 2, 2, 1})},
+		{"negative diagonal", NewDense(2, 2, []float64{-1, 0, 0, 1})},
+		{"singular", NewDense(2, 2, []float64{1, 1, 1, 1})},
+	}
+	for _, tt := range tests {
+		if _, err := tt.a.Cholesky(); !errors.Is(err, ErrNotPositiveDefinite) {
+			t.Errorf("%s: Cholesky() error = %v, want %v", tt.name, err, ErrNotPositiveDefinite)
+		}
+		if _, err := tt.a.LDL(); !errors.Is(err, ErrNotPositiveDefinite) {
+			t.Errorf("%s: LDL() error = %v, want %v", tt.name, err, ErrNotPositiveDefinite)
+		}
+	}
+	if _, err := (Mat3[float64]{{1, 0, 0}, {0, -2, 0}, {0, 0, 1}}).Cholesky(); !errors.Is(err, ErrNotPositiveDefinite) {
+		t.Errorf("Mat3 Cholesky() error = %v, want %v", err, ErrNotPositiveDefinite)
+	}
+}
+
+// TestCholeskyRankOne tests that updates and downdates match refactorizing the modified matrix.
+func TestCholeskyRankOne(t *testing.T) {
+	a := spdDense(4)
+	x := Vector[float64]{0.5, -1, 2, 0.25}
+	updated := a.Add(outer(x))
+
+	c, _ := a.Cholesky()
+	want, _ := updated.Cholesky()
+	up := c.Update(x)
+	if !denseApproxEqual(up.L(), want.L(), 1e-12) {
+		t.Errorf("Update() L = %v, want %v", up.L(), want.L())
+	}
+	down, err := up.Downdate(x)
+	if err != nil || !denseApproxEqual(down.L(), c.L(), 1e-12) {
+		t.Errorf("Downdate() = (%v, %v), want (%v, nil)", down.L(), err, c.L())
+	}
+
+	f, _ := a.LDL()
+	wantLDL, _ := updated.LDL()
+	upLDL := f.Update(x)
+	if !denseApproxEqual(upLDL.L(), wantLDL.L(), 1e-12) || upLDL.D().Sub(wantLDL.D()).Mag() > 1e-12 {
+		t.Errorf("LDL Update() = (%v, %v), want (%v, %v)", upLDL.L(), upLDL.D(), wantLDL.L(), wantLDL.D())
+	}
+	downLDL, err := upLDL.Downdate(x)
+	if err != nil || !denseApproxEqual(downLDL.L(), f.L(), 1e-12) || downLDL.D().Sub(f.D()).Mag() > 1e-12 {
+		t.Errorf("LDL Downdate() = (%v, %v, %v), want (%v, %v, nil)", downLDL.L(), downLDL.D(), err, f.L(), f.D())
+	}
+
+	// Removing more than the matrix holds leaves it indefinite.
+	big := Vector[float64]{10, 0, 0, 0}
+	if _, err := c.Downdate(big); !errors.Is(err, ErrNotPositiveDefinite) {
+		t.Errorf("Downdate() error = %v, want %v", err, ErrNotPositiveDefinite)
+	}
+	if _, err := f.Downdate(big); !errors.Is(err, ErrNotPositiveDefinite) {
+		t.Errorf("LDL Downdate() error = %v, want %v", err, ErrNotPositiveDefinite)
+	}
+}
+
+// TestMatCholesky tests the fixed-size factorization shortcuts.
+func TestMatCholesky(t *testing.T) {
+	c, err := ScaleMat4(Vec3[float64]{4, 9, 16}).Cholesky()
+	if err != nil || !mat4ApproxEqual(c.L().Mat4(), ScaleMat4(Vec3[float64]{2, 3, 4}), 1e-12) {
+		t.Errorf("Mat4 Cholesky() = (%v, %v), want the square root of the diagonal", c.L(), err)
+	}
+	m := Mat3[float64]{{4, 1, 0.5}, {1, 5, 2}, {0.5, 2, 6}}
+	f, err := m.LDL()
+	if err != nil {
+		t.Fatalf("Mat3 LDL() error = %v", err)
+	}
+	b := Vec3[float64]{1, 2, 3}
+	want, _ := m.Solve(b)
+	if got := f.Solve(VectorFromVec3(b)).Vec3(); !vec3ApproxEqual(got, want, 1e-12) {
+		t.Errorf("Mat3 LDL Solve() = %v, want %v", got, want)
+	}
+}
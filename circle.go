@@ -0,0 +1,25 @@
+package bm
+
+// Circle represents a solid disc in 2D space with the given center and radius.
+type Circle[T Numeric] struct {
+	Center Vec2[T]
+	Radius T
+}
+
+/**
+ * NewCircle returns the circle with the given center and radius.
+ * For example:
+ *   NewCircle(Vec2[float64]{0, 0}, 1) returns the unit circle
+ */
+func NewCircle[T Numeric](center Vec2[T], radius T) Circle[T] {
+	return Circle[T]{Center: center, Radius: radius}
+}
+
+/**
+ * Support returns the point of the circle farthest along dir, so a Circle can be used with GJK and EPA.
+ * For example:
+ *   NewCircle(Vec2[float64]{}, 2).Support(Vec2[float64]{0, 5}) returns Vec2[float64]{0, 2}
+ */
+func (c Circle[T]) Support(dir Vec2[T]) Vec2[T] {
+	return c.Center.Add(dir.Norm().Scale(c.Radius))
+}
@@ -0,0 +1,274 @@
+package bm
+
+import "math"
+
+// CatmullRomParam selects how the knots of a Catmull-Rom curve are spaced.
+type CatmullRomParam int
+
+const (
+	// CatmullRomUniform spaces the knots evenly. It is the classic Catmull-Rom spline but can overshoot and form cusps
+	// or loops where neighbouring points are unevenly spaced.
+	CatmullRomUniform CatmullRomParam = iota
+	// CatmullRomCentripetal spaces the knots by the square root of the distance between points, which guarantees no
+	// cusps or self-intersections within a segment.
+	CatmullRomCentripetal
+	// CatmullRomChordal spaces the knots by the distance between points.
+	CatmullRomChordal
+)
+
+/**
+ * String returns the name of the parameterization.
+ * For example:
+ *   CatmullRomCentripetal.String() returns "CatmullRomCentripetal"
+ */
+func (p CatmullRomParam) String() string {
+	switch p {
+	case CatmullRomUniform:
+		return "CatmullRomUniform"
+	case CatmullRomCentripetal:
+		return "CatmullRomCentripetal"
+	case CatmullRomChordal:
+		return "CatmullRomChordal"
+	}
+	return "CatmullRomParam(?)"
+}
+
+// alpha returns the exponent applied to the distance between points to space the knots.
+func (p CatmullRomParam) alpha() float64 {
+	switch p {
+	case CatmullRomCentripetal:
+		return 0.5
+	case CatmullRomChordal:
+		return 1
+	}
+	return 0
+}
+
+/**
+ * Hermite returns the point at t in [0, 1] of the cubic Hermite curve from p0 to p1 with tangents m0 and m1.
+ * For example:
+ *   Hermite(Vec2[float64]{0, 0}, Vec2[float64]{1, 0}, Vec2[float64]{1, 0}, Vec2[float64]{1, 0}, 0.5) returns Vec2[float64]{0.5, 0}
+ */
+func Hermite[V CurvePoint[V, T], T Numeric](p0, m0, p1, m1 V, t T) V {
+	t2 := t * t
+	t3 := t2 * t
+	return p0.Scale(2*t3 - 3*t2 + 1).Add(m0.Scale(t3 - 2*t2 + t)).Add(p1.Scale(3*t2 - 2*t3)).Add(m1.Scale(t3 - t2))
+}
+
+/**
+ * HermiteTangent returns the derivative with respect to t of the cubic Hermite curve from p0 to p1 with tangents m0
+ * and m1. It equals m0 at t = 0 and m1 at t = 1.
+ * For example:
+ *   HermiteTangent(Vec2[float64]{0, 0}, Vec2[float64]{1, 0}, Vec2[float64]{1, 0}, Vec2[float64]{1, 0}, 0.5) returns Vec2[float64]{1, 0}
+ */
+func HermiteTangent[V CurvePoint[V, T], T Numeric](p0, m0, p1, m1 V, t T) V {
+	t2 := t * t
+	return p0.Scale(6*t2 - 6*t).Add(m0.Scale(3*t2 - 4*t + 1)).Add(p1.Scale(6*t - 6*t2)).Add(m1.Scale(3*t2 - 2*t))
+}
+
+// HermiteCurve is a C1 piecewise cubic curve through a sequence of points with a given tangent at each point.
+// Segment i runs from Points[i] to Points[i+1] and the curve is parameterized over [0, len(Points)-1], so that At(i)
+// returns Points[i].
+type HermiteCurve[V CurvePoint[V, T], T Numeric] struct {
+	Points, Tangents []V
+}
+
+/**
+ * NewHermiteCurve returns the Hermite curve through the points with the given tangents. It panics if the slices differ
+ * in length or hold fewer than two points.
+ * For example:
+ *   NewHermiteCurve([]Vec2[float64]{{0, 0}, {1, 1}}, []Vec2[float64]{{1, 0}, {0, 1}}) returns a quarter-turn curve
+ */
+func NewHermiteCurve[V CurvePoint[V, T], T Numeric](points, tangents []V) HermiteCurve[V, T] {
+	if len(points) != len(tangents) || len(points) < 2 {
+		panic("bm: Hermite curve needs at least two points and one tangent per point")
+	}
+	return HermiteCurve[V, T]{Points: points, Tangents: tangents}
+}
+
+/**
+ * Segments returns the number of cubic segments, one less than the number of points.
+ * For example:
+ *   a Hermite curve through four points returns 3
+ */
+func (c HermiteCurve[V, T]) Segments() int {
+	return len(c.Points) - 1
+}
+
+/**
+ * At returns the point of the curve at parameter u in [0, Segments()]. Values of u outside the range continue the
+ * first or last segment.
+ * For example:
+ *   NewHermiteCurve(points, tangents).At(1) returns points[1]
+ */
+func (c HermiteCurve[V, T]) At(u T) V {
+	i, t := curveSegment(u, c.Segments())
+	return Hermite(c.Points[i], c.Tangents[i], c.Points[i+1], c.Tangents[i+1], t)
+}
+
+/**
+ * Tangent returns the derivative of the curve with respect to u.
+ * For example:
+ *   NewHermiteCurve(points, tangents).Tangent(1) returns tangents[1]
+ */
+func (c HermiteCurve[V, T]) Tangent(u T) V {
+	i, t := curveSegment(u, c.Segments())
+	return HermiteTangent(c.Points[i], c.Tangents[i], c.Points[i+1], c.Tangents[i+1], t)
+}
+
+// CatmullRomCurve is a piecewise cubic curve that passes through every point of a sequence, with tangents taken from
+// the neighbouring points. The curve is parameterized over [0, len(Points)-1], so that At(i) returns Points[i],
+// whatever the knot spacing. With uniform spacing it is C1. With centripetal or chordal spacing the tangent direction
+// is continuous but its length changes at the points, since each segment is rescaled to unit length. The first and
+// last segments use phantom points mirrored through the end points. The methods are meant for float types, since the
+// tangents weight the neighbouring points by fractions of the knot spacing.
+type CatmullRomCurve[V CurvePoint[V, T], T Numeric] struct {
+	Points []V
+	Param  CatmullRomParam
+}
+
+/**
+ * NewCatmullRomCurve returns the Catmull-Rom curve through the points with the given knot spacing. It panics if there
+ * are fewer than two points.
+ * For example:
+ *   NewCatmullRomCurve([]Vec3[float64]{{0, 0, 0}, {1, 2, 0}, {3, 2, 0}}, CatmullRomCentripetal).At(1) returns Vec3[float64]{1, 2, 0}
+ */
+func NewCatmullRomCurve[V CurvePoint[V, T], T Numeric](points []V, param CatmullRomParam) CatmullRomCurve[V, T] {
+	if len(points) < 2 {
+		panic("bm: Catmull-Rom curve needs at least two points")
+	}
+	return CatmullRomCurve[V, T]{Points: points, Param: param}
+}
+
+/**
+ * Segments returns the number of cubic segments, one less than the number of points.
+ * For example:
+ *   a Catmull-Rom curve through four points returns 3
+ */
+func (c CatmullRomCurve[V, T]) Segments() int {
+	return len(c.Points) - 1
+}
+
+/**
+ * At returns the point of the curve at parameter u in [0, Segments()]. Values of u outside the range continue the
+ * first or last segment.
+ * For example:
+ *   NewCatmullRomCurve([]Vec2[float64]{{0, 0}, {1, 0}, {2, 0}}, CatmullRomUniform).At(0.5) returns Vec2[float64]{0.5, 0}
+ */
+func (c CatmullRomCurve[V, T]) At(u T) V {
+	p1, m1, p2, m2, t := c.segment(u)
+	return Hermite(p1, m1, p2, m2, t)
+}
+
+/**
+ * Tangent returns the derivative of the curve with respect to u.
+ * For example:
+ *   NewCatmullRomCurve([]Vec2[float64]{{0, 0}, {1, 0}, {2, 0}}, CatmullRomUniform).Tangent(0.5) returns Vec2[float64]{1, 0}
+ */
+func (c CatmullRomCurve[V, T]) Tangent(u T) V {
+	p1, m1, p2, m2, t := c.segment(u)
+	return HermiteTangent(p1, m1, p2, m2, t)
+}
+
+// segment returns the Hermite form of the segment containing u and the local parameter within it. With knots
+// t0 < t1 < t2 < t3 spaced by the chosen parameterization, the tangents at P1 and P2 are those of the Barry-Goldman
+// pyramid, rescaled from [t1, t2] to [0, 1].
+func (c CatmullRomCurve[V, T]) segment(u T) (p1, m1, p2, m2 V, t T) {
+	i, t := curveSegment(u, c.Segments())
+	n := len(c.Points)
+	p1, p2 = c.Points[i], c.Points[i+1]
+	var p0, p3 V
+	if i > 0 {
+		p0 = c.Points[i-1]
+	} else {
+		p0 = p1.Add(p1.Sub(p2))
+	}
+	if i+2 < n {
+		p3 = c.Points[i+2]
+	} else {
+		p3 = p2.Add(p2.Sub(p1))
+	}
+
+	alpha := c.Param.alpha()
+	d0, d1, d2 := knotInterval(p0, p1, alpha), knotInterval(p1, p2, alpha), knotInterval(p2, p3, alpha)
+	m1 = p1.Sub(p0).Scale(T(d1 / d0)).Sub(p2.Sub(p0).Scale(T(d1 / (d0 + d1)))).Add(p2.Sub(p1))
+	m2 = p2.Sub(p1).Sub(p3.Sub(p1).Scale(T(d1 / (d1 + d2)))).Add(p3.Sub(p2).Scale(T(d1 / d2)))
+	return p1, m1, p2, m2, t
+}
+
+// knotInterval returns |b - a|^alpha, the knot spacing between two points. Coincident points get a spacing of one so
+// that the tangents stay finite.
+func knotInterval[V CurvePoint[V, T], T Numeric](a, b V, alpha float64) float64 {
+	d := b.Sub(a)
+	dist := float64(d.Dot(d))
+	if alpha == 0 || dist == 0 {
+		return 1
+	}
+	return math.Pow(dist, alpha/2)
+}
+
+// BSplineCurve is a uniform cubic B-spline with the given control points. It is C2 but, unlike the Catmull-Rom curve,
+// only approximates its control points. Segment i is shaped by Points[i] to Points[i+3] and the curve is parameterized
+// over [0, len(Points)-3].
+type BSplineCurve[V CurvePoint[V, T], T Numeric] struct {
+	Points []V
+}
+
+/**
+ * NewBSplineCurve returns the uniform cubic B-spline with the given control points. It panics if there are fewer than
+ * four points.
+ * For example:
+ *   NewBSplineCurve([]Vec2[float64]{{0, 0}, {1, 0}, {2, 0}, {3, 0}}).At(0) returns Vec2[float64]{1, 0}
+ */
+func NewBSplineCurve[V CurvePoint[V, T], T Numeric](points []V) BSplineCurve[V, T] {
+	if len(points) < 4 {
+		panic("bm: cubic B-spline curve needs at least four control points")
+	}
+	return BSplineCurve[V, T]{Points: points}
+}
+
+/**
+ * Segments returns the number of cubic segments, three less than the number of control points.
+ * For example:
+ *   a B-spline curve with six control points returns 3
+ */
+func (c BSplineCurve[V, T]) Segments() int {
+	return len(c.Points) - 3
+}
+
+/**
+ * At returns the point of the curve at parameter u in [0, Segments()]. Values of u outside the range continue the
+ * first or last segment.
+ * For example:
+ *   NewBSplineCurve([]Vec2[float64]{{0, 0}, {0, 6}, {6, 6}, {6, 0}}).At(0.5) returns Vec2[float64]{3, 5.75}
+ */
+func (c BSplineCurve[V, T]) At(u T) V {
+	i, t := curveSegment(u, c.Segments())
+	s := 1 - t
+	t2, s2 := t*t, s*s
+	p := c.Points[i : i+4]
+	sum := p[0].Scale(s2 * s).Add(p[1].Scale(4 - 3*t2*(1+s))).Add(p[2].Scale(4 - 3*s2*(1+t))).Add(p[3].Scale(t2 * t))
+	return sum.ScalarDiv(6)
+}
+
+/**
+ * Tangent returns the derivative of the curve with respect to u.
+ * For example:
+ *   NewBSplineCurve([]Vec2[float64]{{0, 0}, {1, 0}, {2, 0}, {3, 0}}).Tangent(0.5) returns Vec2[float64]{1, 0}
+ */
+func (c BSplineCurve[V, T]) Tangent(u T) V {
+	i, t := curveSegment(u, c.Segments())
+	s := 1 - t
+	// The derivative is the quadratic B-spline of the differences of the control points.
+	p := c.Points[i : i+4]
+	sum := p[1].Sub(p[0]).Scale(s * s).Add(p[2].Sub(p[1]).Scale(1 + 2*t*s)).Add(p[3].Sub(p[2]).Scale(t * t))
+	return sum.ScalarDiv(2)
+}
+
+// curveSegment maps a curve parameter u in [0, segments] to the index of its segment and the local parameter in
+// [0, 1]. Parameters outside the range fall in the first or last segment with a local parameter outside [0, 1].
+func curveSegment[T Numeric](u T, segments int) (int, T) {
+	i := int(math.Floor(float64(u)))
+	i = max(0, min(i, segments-1))
+	return i, u - T(i)
+}
@@ -0,0 +1,147 @@
+package bm
+
+import (
+	"math"
+	"testing"
+)
+
+// TestHermite tests the Hermite basis at its ends and against a known cubic.
+func TestHermite(t *testing.T) {
+	p0, m0 := Vec3[float64]{0, 0, 0}, Vec3[float64]{1, 0, 2}
+	p1, m1 := Vec3[float64]{2, 1, 0}, Vec3[float64]{0, 3, -1}
+	if got := Hermite(p0, m0, p1, m1, 0); got != p0 {
+		t.Errorf("Hermite(0) = %v, want %v", got, p0)
+	}
+	if got := Hermite(p0, m0, p1, m1, 1); got != p1 {
+		t.Errorf("Hermite(1) = %v, want %v", got, p1)
+	}
+	if got := HermiteTangent(p0, m0, p1, m1, 0); got != m0 {
+		t.Errorf("HermiteTangent(0) = %v, want %v", got, m0)
+	}
+	if got := HermiteTangent(p0, m0, p1, m1, 1); got != m1 {
+		t.Errorf("HermiteTangent(1) = %v, want %v", got, m1)
+	}
+
+	// The Hermite form of the cubic Bézier curve {p0, p0 + m0/3, p1 - m1/3, p1}.
+	bezier := BezierCurve3[float64]{p0, p0.Add(m0.Scale(1.0 / 3)), p1.Sub(m1.Scale(1.0 / 3)), p1}
+	for _, v := range []float64{0.2, 0.5, 0.7} {
+		if got, want := Hermite(p0, m0, p1, m1, v), bezier.At(v); !vec3ApproxEqual(got, want, 1e-12) {
+			t.Errorf("Hermite(%v) = %v, want %v", v, got, want)
+		}
+	}
+
+	c := NewHermiteCurve([]Vec2[float64]{{0, 0}, {1, 1}, {3, 0}}, []Vec2[float64]{{1, 0}, {0, 1}, {1, -1}})
+	if got := c.Segments(); got != 2 {
+		t.Errorf("Segments() = %d, want 2", got)
+	}
+	for i, p := range c.Points {
+		if got := c.At(float64(i)); got.Dist(p) > 1e-12 {
+			t.Errorf("At(%d) = %v, want %v", i, got, p)
+		}
+		if got := c.Tangent(float64(i)); got.Dist(c.Tangents[i]) > 1e-12 {
+			t.Errorf("Tangent(%d) = %v, want %v", i, got, c.Tangents[i])
+		}
+	}
+}
+
+// TestCatmullRomCurve tests interpolation, continuity of the tangent direction and the classic uniform tangents.
+func TestCatmullRomCurve(t *testing.T) {
+	points := []Vec2[float64]{{0, 0}, {1, 3}, {1.2, 3.1}, {4, 0}, {5, 2}}
+	const h = 1e-6
+	for _, param := range []CatmullRomParam{CatmullRomUniform, CatmullRomCentripetal, CatmullRomChordal} {
+		c := NewCatmullRomCurve(points, param)
+		for i, p := range points {
+			if got := c.At(float64(i)); got.Dist(p) > 1e-12 {
+				t.Errorf("%v: At(%d) = %v, want %v", param, i, got, p)
+			}
+		}
+		for i := 1; i < len(points)-1; i++ {
+			u := float64(i)
+			l, r := c.Tangent(u-1e-12), c.Tangent(u+1e-12)
+			if param != CatmullRomUniform {
+				l, r = l.Norm(), r.Norm()
+			}
+			if l.Dist(r) > 1e-6 {
+				t.Errorf("%v: Tangent jumps at %v: %v != %v", param, u, l, r)
+			}
+		}
+		for _, u := range []float64{0.3, 1.5, 2.2, 3.9} {
+			want := c.At(u + h).Sub(c.At(u - h)).Scale(1 / (2 * h))
+			if got := c.Tangent(u); got.Dist(want) > 1e-5 {
+				t.Errorf("%v: Tangent(%v) = %v, want %v", param, u, got, want)
+			}
+		}
+	}
+
+	uniform := NewCatmullRomCurve(points, CatmullRomUniform)
+	if got, want := uniform.Tangent(2), points[3].Sub(points[1]).Scale(0.5); got.Dist(want) > 1e-12 {
+		t.Errorf("uniform Tangent(2) = %v, want %v", got, want)
+	}
+
+	// Evenly spaced collinear points give the same straight line under every parameterization.
+	line := []Vec4[float64]{{0, 0, 0, 0}, {1, 1, 1, 1}, {2, 2, 2, 2}, {3, 3, 3, 3}}
+	for _, param := range []CatmullRomParam{CatmullRomUniform, CatmullRomCentripetal, CatmullRomChordal} {
+		if got := NewCatmullRomCurve(line, param).At(1.25); got.Sub(Vec4[float64]{1.25, 1.25, 1.25, 1.25}).Mag() > 1e-12 {
+			t.Errorf("%v: line At(1.25) = %v, want {1.25 1.25 1.25 1.25}", param, got)
+		}
+	}
+	if got := CatmullRomChordal.String(); got != "CatmullRomChordal" {
+		t.Errorf("String() = %q, want %q", got, "CatmullRomChordal")
+	}
+}
+
+// TestCatmullRomCentripetalNoCusp tests that the centripetal curve stays close to its points where the uniform one
+// overshoots.
+func TestCatmullRomCentripetalNoCusp(t *testing.T) {
+	points := []Vec2[float64]{{0, 0}, {1, 0}, {1.01, 0.01}, {2, 0}}
+	overshoot := func(c CatmullRomCurve[Vec2[float64], float64]) float64 {
+		var worst float64
+		for k := 0; k <= 1000; k++ {
+			p := c.At(1 + float64(k)/1000)
+			worst = math.Max(worst, math.Max(p.X-1.01, 1-p.X))
+		}
+		return worst
+	}
+	uniform := overshoot(NewCatmullRomCurve(points, CatmullRomUniform))
+	centripetal := overshoot(NewCatmullRomCurve(points, CatmullRomCentripetal))
+	if centripetal >= uniform || centripetal > 0.01 {
+		t.Errorf("overshoot of the short segment = %v centripetal, %v uniform; want centripetal small", centripetal, uniform)
+	}
+}
+
+// TestBSplineCurve tests the uniform cubic B-spline against its matrix form and its continuity.
+func TestBSplineCurve(t *testing.T) {
+	points := []Vec3[float64]{{0, 0, 0}, {0, 6, 0}, {6, 6, 3}, {6, 0, 0}, {9, 3, -3}}
+	c := NewBSplineCurve(points)
+	if got := c.Segments(); got != 2 {
+		t.Errorf("Segments() = %d, want 2", got)
+	}
+	// At the knots the curve is (P[i] + 4P[i+1] + P[i+2]) / 6 with tangent (P[i+2] - P[i]) / 2.
+	for i := 0; i <= c.Segments(); i++ {
+		want := points[i].Add(points[i+1].Scale(4)).Add(points[i+2]).Scale(1.0 / 6)
+		if got := c.At(float64(i)); !vec3ApproxEqual(got, want, 1e-12) {
+			t.Errorf("At(%d) = %v, want %v", i, got, want)
+		}
+		tangent := points[i+2].Sub(points[i]).Scale(0.5)
+		if got := c.Tangent(float64(i)); !vec3ApproxEqual(got, tangent, 1e-12) {
+			t.Errorf("Tangent(%d) = %v, want %v", i, got, tangent)
+		}
+	}
+	if got := c.At(0.5); !vec3ApproxEqual(got, Vec3[float64]{3, 5.75, 1.4375}, 1e-12) {
+		t.Errorf("At(0.5) = %v, want {3 5.75 1.4375}", got)
+	}
+	ints := NewBSplineCurve([]Vec2[int]{{0, 0}, {0, 6}, {6, 6}, {6, 0}})
+	if got := ints.At(0); got != (Vec2[int]{1, 5}) {
+		t.Errorf("integer At(0) = %v, want {1 5}", got)
+	}
+	if got := ints.Tangent(1); got != (Vec2[int]{3, -3}) {
+		t.Errorf("integer Tangent(1) = %v, want {3 -3}", got)
+	}
+	const h = 1e-6
+	for _, u := range []float64{0.25, 1.6} {
+		want := c.At(u + h).Sub(c.At(u - h)).Scale(1 / (2 * h))
+		if got := c.Tangent(u); !vec3ApproxEqual(got, want, 1e-6) {
+			t.Errorf("Tangent(%v) = %v, want %v", u, got, want)
+		}
+	}
+}
@@ -0,0 +1,453 @@
+package bm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dense represents a heap-backed matrix of arbitrary size with elements of type T stored in row-major order.
+// Copies of a Dense share their elements; use Clone for an independent copy. Operations on matrices of
+// incompatible sizes panic.
+type Dense[T Numeric] struct {
+	rows, cols int
+	data       []T
+}
+
+/**
+ * NewDense returns a rows x cols matrix backed by data in row-major order. If data is nil, a zero matrix is allocated.
+ * It panics if the size is negative or data does not have rows*cols elements.
+ * For example:
+ *   NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}) returns the matrix [[1, 2, 3], [4, 5, 6]]
+ */
+func NewDense[T Numeric](rows, cols int, data []T) Dense[T] {
+	if rows < 0 || cols < 0 {
+		panic(fmt.Sprintf("bm: negative matrix size %dx%d", rows, cols))
+	}
+	if data == nil {
+		data = make([]T, rows*cols)
+	}
+	if len(data) != rows*cols {
+		panic(fmt.Sprintf("bm: %d elements do not fill a %dx%d matrix", len(data), rows, cols))
+	}
+	return Dense[T]{rows: rows, cols: cols, data: data}
+}
+
+/**
+ * IdentityDense returns the n x n identity matrix.
+ * For example:
+ *   IdentityDense[int](2) returns the matrix [[1, 0], [0, 1]]
+ */
+func IdentityDense[T Numeric](n int) Dense[T] {
+	d := NewDense[T](n, n, nil)
+	for i := 0; i < n; i++ {
+		d.data[i*n+i] = 1
+	}
+	return d
+}
+
+/**
+ * DenseFromMat2 returns a 2x2 dense copy of m.
+ * For example:
+ *   DenseFromMat2(Mat2[int]{ {1, 2}, {3, 4} }) returns the matrix [[1, 2], [3, 4]]
+ */
+func DenseFromMat2[T Numeric](m Mat2[T]) Dense[T] {
+	return NewDense(2, 2, []T{m[0][0], m[0][1], m[1][0], m[1][1]})
+}
+
+/**
+ * DenseFromMat3 returns a 3x3 dense copy of m.
+ * For example:
+ *   DenseFromMat3(IdentityMat3[int]()) returns IdentityDense[int](3)
+ */
+func DenseFromMat3[T Numeric](m Mat3[T]) Dense[T] {
+	d := NewDense[T](3, 3, nil)
+	for i := 0; i < 3; i++ {
+		copy(d.data[i*3:], m[i][:])
+	}
+	return d
+}
+
+/**
+ * DenseFromMat4 returns a 4x4 dense copy of m.
+ * For example:
+ *   DenseFromMat4(IdentityMat4[int]()) returns IdentityDense[int](4)
+ */
+func DenseFromMat4[T Numeric](m Mat4[T]) Dense[T] {
+	d := NewDense[T](4, 4, nil)
+	for i := 0; i < 4; i++ {
+		copy(d.data[i*4:], m[i][:])
+	}
+	return d
+}
+
+/**
+ * Mat2 returns the matrix as a Mat2. It panics if the matrix is not 2x2.
+ * For example:
+ *   NewDense(2, 2, []int{1, 2, 3, 4}).Mat2() returns Mat2[int]{ {1, 2}, {3, 4} }
+ */
+func (d Dense[T]) Mat2() Mat2[T] {
+	d.mustDims(2, 2)
+	var m Mat2[T]
+	for i := range m {
+		copy(m[i][:], d.data[i*2:])
+	}
+	return m
+}
+
+/**
+ * Mat3 returns the matrix as a Mat3. It panics if the matrix is not 3x3.
+ * For example:
+ *   IdentityDense[int](3).Mat3() returns IdentityMat3[int]()
+ */
+func (d Dense[T]) Mat3() Mat3[T] {
+	d.mustDims(3, 3)
+	var m Mat3[T]
+	for i := range m {
+		copy(m[i][:], d.data[i*3:])
+	}
+	return m
+}
+
+/**
+ * Mat4 returns the matrix as a Mat4. It panics if the matrix is not 4x4.
+ * For example:
+ *   IdentityDense[int](4).Mat4() returns IdentityMat4[int]()
+ */
+func (d Dense[T]) Mat4() Mat4[T] {
+	d.mustDims(4, 4)
+	var m Mat4[T]
+	for i := range m {
+		copy(m[i][:], d.data[i*4:])
+	}
+	return m
+}
+
+/**
+ * Dims returns the number of rows and columns of the matrix.
+ * For example:
+ *   NewDense[int](2, 3, nil).Dims() returns (2, 3)
+ */
+func (d Dense[T]) Dims() (int, int) {
+	return d.rows, d.cols
+}
+
+/**
+ * At returns the element at row i and column j. It panics if the indices are out of range.
+ * For example:
+ *   NewDense(2, 2, []int{1, 2, 3, 4}).At(1, 0) returns 3
+ */
+func (d Dense[T]) At(i, j int) T {
+	return d.data[d.index(i, j)]
+}
+
+/**
+ * Set sets the element at row i and column j, which is visible through every copy of d. It panics if the indices are out of range.
+ * For example:
+ *   d.Set(1, 0, 5) makes d.At(1, 0) return 5
+ */
+func (d Dense[T]) Set(i, j int, v T) {
+	d.data[d.index(i, j)] = v
+}
+
+/**
+ * Row returns a copy of row i.
+ * For example:
+ *   NewDense(2, 2, []int{1, 2, 3, 4}).Row(1) returns Vector[int]{3, 4}
+ */
+func (d Dense[T]) Row(i int) Vector[T] {
+	d.index(i, 0)
+	return Vector[T](d.data[i*d.cols : (i+1)*d.cols]).Clone()
+}
+
+/**
+ * Col returns a copy of column j.
+ * For example:
+ *   NewDense(2, 2, []int{1, 2, 3, 4}).Col(1) returns Vector[int]{2, 4}
+ */
+func (d Dense[T]) Col(j int) Vector[T] {
+	d.index(0, j)
+	v := make(Vector[T], d.rows)
+	for i := range v {
+		v[i] = d.data[i*d.cols+j]
+	}
+	return v
+}
+
+/**
+ * Clone returns a copy of the matrix that does not share its elements.
+ * For example:
+ *   d.Clone().Set(0, 0, 1) leaves d unchanged
+ */
+func (d Dense[T]) Clone() Dense[T] {
+	return Dense[T]{rows: d.rows, cols: d.cols, data: append([]T(nil), d.data...)}
+}
+
+/**
+ * Add adds another matrix of the same size and returns the result.
+ * For example:
+ *   NewDense(1, 2, []int{1, 2}).Add(NewDense(1, 2, []int{3, 4})) returns the matrix [[4, 6]]
+ */
+func (d Dense[T]) Add(other Dense[T]) Dense[T] {
+	d.mustDims(other.rows, other.cols)
+	out := NewDense[T](d.rows, d.cols, nil)
+	for i := range d.data {
+		out.data[i] = d.data[i] + other.data[i]
+	}
+	return out
+}
+
+/**
+ * Sub subtracts another matrix of the same size and returns the result.
+ * For example:
+ *   NewDense(1, 2, []int{4, 6}).Sub(NewDense(1, 2, []int{3, 4})) returns the matrix [[1, 2]]
+ */
+func (d Dense[T]) Sub(other Dense[T]) Dense[T] {
+	d.mustDims(other.rows, other.cols)
+	out := NewDense[T](d.rows, d.cols, nil)
+	for i := range d.data {
+		out.data[i] = d.data[i] - other.data[i]
+	}
+	return out
+}
+
+/**
+ * Scale multiplies every element by a scalar and returns the result.
+ * For example:
+ *   NewDense(1, 2, []int{1, 2}).Scale(3) returns the matrix [[3, 6]]
+ */
+func (d Dense[T]) Scale(scalar T) Dense[T] {
+	out := NewDense[T](d.rows, d.cols, nil)
+	for i, v := range d.data {
+		out.data[i] = v * scalar
+	}
+	return out
+}
+
+/**
+ * Mul returns the matrix product d * other. It panics if d has a different number of columns than other has rows.
+ * For example:
+ *   NewDense(1, 2, []int{1, 2}).Mul(NewDense(2, 1, []int{3, 4})) returns the matrix [[11]]
+ */
+func (d Dense[T]) Mul(other Dense[T]) Dense[T] {
+	if d.cols != other.rows {
+		panic(fmt.Sprintf("bm: cannot multiply %dx%d and %dx%d matrices", d.rows, d.cols, other.rows, other.cols))
+	}
+	out := NewDense[T](d.rows, other.cols, nil)
+	for i := 0; i < d.rows; i++ {
+		row := out.data[i*out.cols : (i+1)*out.cols]
+		for k := 0; k < d.cols; k++ {
+			a := d.data[i*d.cols+k]
+			if a == 0 {
+				continue
+			}
+			for j, b := range other.data[k*other.cols : (k+1)*other.cols] {
+				row[j] += a * b
+			}
+		}
+	}
+	return out
+}
+
+/**
+ * MulVec returns the matrix-vector product d * v. It panics if v's length differs from the number of columns.
+ * For example:
+ *   NewDense(2, 2, []int{1, 2, 3, 4}).MulVec(Vector[int]{1, 1}) returns Vector[int]{3, 7}
+ */
+func (d Dense[T]) MulVec(v Vector[T]) Vector[T] {
+	v.mustLen(d.cols)
+	out := make(Vector[T], d.rows)
+	for i := range out {
+		out[i] = Vector[T](d.data[i*d.cols : (i+1)*d.cols]).Dot(v)
+	}
+	return out
+}
+
+/**
+ * Transpose returns the transpose of the matrix.
+ * For example:
+ *   NewDense(1, 2, []int{1, 2}).Transpose() returns the matrix [[1], [2]]
+ */
+func (d Dense[T]) Transpose() Dense[T] {
+	out := NewDense[T](d.cols, d.rows, nil)
+	for i := 0; i < d.rows; i++ {
+		for j := 0; j < d.cols; j++ {
+			out.data[j*d.rows+i] = d.data[i*d.cols+j]
+		}
+	}
+	return out
+}
+
+/**
+ * Determinant calculates the determinant of a square matrix from its LU decomposition, returning 0 for numerically
+ * singular matrices. For integer types it uses fraction-free elimination, which is exact. It panics if the matrix is
+ * not square.
+ * For example:
+ *   NewDense(2, 2, []float64{1, 2, 3, 4}).Determinant() returns -2
+ *   NewDense(2, 2, []int{2, 1, 1, 3}).Determinant() returns 5
+ */
+func (d Dense[T]) Determinant() T {
+	if isIntegral[T]() {
+		d.mustSquare()
+		pivot, sign := bareiss(d.Clone().data, d.rows, d.cols)
+		if sign < 0 {
+			return -pivot
+		}
+		return pivot
+	}
+	return d.LU().Determinant()
+}
+
+/**
+ * Inverse returns the inverse of a square matrix computed from its LU decomposition and a boolean indicating success.
+ * For integer types the inverse is computed exactly by fraction-free elimination and fails unless every entry is an
+ * integer. It panics if the matrix is not square.
+ * For example:
+ *   NewDense(2, 2, []float64{2, 0, 0, 4}).Inverse() returns (the matrix [[0.5, 0], [0, 0.25]], true)
+ *   NewDense(2, 2, []float64{1, 2, 2, 4}).Inverse() returns (Dense[float64]{}, false) (as this matrix is singular)
+ *   NewDense(2, 2, []int{2, 1, 1, 3}).Inverse() returns (Dense[int]{}, false) (as the inverse is not integral)
+ */
+func (d Dense[T]) Inverse() (Dense[T], bool) {
+	inv, err := d.InverseE()
+	return inv, err == nil
+}
+
+/**
+ * InverseE returns the inverse of a square matrix like Inverse, or ErrSingular if the matrix is numerically singular
+ * or, for integer types, its inverse is not integral. It panics if the matrix is not square.
+ * For example:
+ *   NewDense(2, 2, []float64{1, 2, 2, 4}).InverseE() returns (Dense[float64]{}, ErrSingular)
+ */
+func (d Dense[T]) InverseE() (Dense[T], error) {
+	if isIntegral[T]() {
+		return d.exactSolve(IdentityDense[T](d.rows))
+	}
+	return d.LU().Inverse()
+}
+
+/**
+ * Solve returns the solution x of d * x = b using LU decomposition with partial pivoting, without forming the inverse.
+ * It returns ErrSingular if d is numerically singular or, for integer types, x is not integral, and panics if d is not
+ * square or b has the wrong length.
+ * For example:
+ *   NewDense(2, 2, []float64{2, 1, 1, 3}).Solve(Vector[float64]{3, 5}) returns (Vector[float64]{0.8, 1.4}, nil)
+ */
+func (d Dense[T]) Solve(b Vector[T]) (Vector[T], error) {
+	if isIntegral[T]() {
+		x, err := d.exactSolve(NewDense(len(b), 1, b))
+		if err != nil {
+			return nil, err
+		}
+		return Vector[T](x.data), nil
+	}
+	return d.LU().Solve(b)
+}
+
+/**
+ * Norm calculates the Frobenius norm of the matrix.
+ * For example:
+ *   NewDense(2, 2, []float64{1, 2, 3, 4}).Norm() returns approximately 5.477
+ */
+func (d Dense[T]) Norm() T {
+	return Vector[T](d.data).Mag()
+}
+
+/**
+ * String returns a string representation of the matrix.
+ * For example:
+ *   NewDense(2, 2, []int{1, 2, 3, 4}).String() returns "[[1, 2], [3, 4]]"
+ */
+func (d Dense[T]) String() string {
+	rows := make([]string, d.rows)
+	for i := range rows {
+		rows[i] = Vector[T](d.data[i*d.cols : (i+1)*d.cols]).String()
+	}
+	return "[" + strings.Join(rows, ", ") + "]"
+}
+
+// index returns the position of element (i, j) in data, panicking if it is out of range.
+func (d Dense[T]) index(i, j int) int {
+	mustIndex(i, j, d.rows, d.cols)
+	return i*d.cols + j
+}
+
+// mustDims panics if the matrix is not rows x cols.
+func (d Dense[T]) mustDims(rows, cols int) {
+	if d.rows != rows || d.cols != cols {
+		panic(fmt.Sprintf("bm: matrix size mismatch: %dx%d != %dx%d", d.rows, d.cols, rows, cols))
+	}
+}
+
+// mustSquare panics if the matrix is not square.
+func (d Dense[T]) mustSquare() {
+	if d.rows != d.cols {
+		panic(fmt.Sprintf("bm: %dx%d matrix is not square", d.rows, d.cols))
+	}
+}
+
+// exactSolve solves d * X = b by fraction-free elimination, returning ErrSingular if d is singular or X has an entry
+// that is not an integer. It keeps Inverse and Solve exact for integer types.
+func (d Dense[T]) exactSolve(b Dense[T]) (Dense[T], error) {
+	d.mustSquare()
+	b.mustDims(d.rows, b.cols)
+	n, cols := d.rows, d.rows+b.cols
+	a := make([]T, n*cols)
+	for i := 0; i < n; i++ {
+		copy(a[i*cols:], d.data[i*n:(i+1)*n])
+		copy(a[i*cols+n:], b.data[i*b.cols:(i+1)*b.cols])
+	}
+	pivot, _ := bareiss(a, n, cols)
+	if pivot == 0 {
+		return Dense[T]{}, ErrSingular
+	}
+	x := NewDense[T](n, b.cols, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < b.cols; j++ {
+			v := a[i*cols+n+j]
+			q := v / pivot
+			if q*pivot != v {
+				return Dense[T]{}, ErrSingular
+			}
+			x.data[i*b.cols+j] = q
+		}
+	}
+	return x, nil
+}
+
+// bareiss runs Bareiss' fraction-free Gauss-Jordan elimination in place on the n x cols row-major matrix a, whose first
+// n columns are square. Every division is exact for integer types. It leaves the last pivot times the identity in the
+// first n columns and returns that pivot, which is the determinant up to the returned sign of the row exchanges, or 0
+// if the matrix is singular.
+func bareiss[T Numeric](a []T, n, cols int) (T, int) {
+	var prev T = 1
+	sign := 1
+	for k := 0; k < n; k++ {
+		p := k
+		for p < n && a[p*cols+k] == 0 {
+			p++
+		}
+		if p == n {
+			return 0, sign
+		}
+		if p != k {
+			for j := 0; j < cols; j++ {
+				a[p*cols+j], a[k*cols+j] = a[k*cols+j], a[p*cols+j]
+			}
+			sign = -sign
+		}
+		pivot := a[k*cols+k]
+		for i := 0; i < n; i++ {
+			if i == k {
+				continue
+			}
+			f := a[i*cols+k]
+			for j := 0; j < cols; j++ {
+				if j != k {
+					a[i*cols+j] = (pivot*a[i*cols+j] - f*a[k*cols+j]) / prev
+				}
+			}
+			a[i*cols+k] = 0
+		}
+		prev = pivot
+	}
+	return prev, sign
+}
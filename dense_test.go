@@ -0,0 +1,159 @@
+package bm
+
+import (
+	"errors"
+	"testing"
+)
+
+// denseApproxEqual reports whether two dense matrices have the same size and elements within eps.
+func denseApproxEqual(a, b Dense[float64], eps float64) bool {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		return false
+	}
+	for i := 0; i < ar; i++ {
+		for j := 0; j < ac; j++ {
+			if !approxEqual(a.At(i, j), b.At(i, j), eps) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// TestDenseArithmetic tests element access and the basic dense matrix operations.
+func TestDenseArithmetic(t *testing.T) {
+	a := NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6})
+	b := NewDense(3, 2, []float64{7, 8, 9, 10, 11, 12})
+
+	if got, expected := a.Mul(b), NewDense(2, 2, []float64{58, 64, 139, 154}); !denseApproxEqual(got, expected, 0) {
+		t.Errorf("Dense Mul() = %v, want %v", got, expected)
+	}
+	if got, expected := a.Transpose(), NewDense(3, 2, []float64{1, 4, 2, 5, 3, 6}); !denseApproxEqual(got, expected, 0) {
+		t.Errorf("Dense Transpose() = %v, want %v", got, expected)
+	}
+	if got, expected := a.Add(a).Sub(a.Scale(3)), a.Scale(-1); !denseApproxEqual(got, expected, 0) {
+		t.Errorf("Dense Add/Sub/Scale = %v, want %v", got, expected)
+	}
+	if got, expected := a.MulVec(Vector[float64]{1, 0, -1}), (Vector[float64]{-2, -2}); got.Sub(expected).Mag() != 0 {
+		t.Errorf("Dense MulVec() = %v, want %v", got, expected)
+	}
+	if got := a.Row(1); got.Sub(Vector[float64]{4, 5, 6}).Mag() != 0 {
+		t.Errorf("Dense Row(1) = %v", got)
+	}
+	if got := a.Col(2); got.Sub(Vector[float64]{3, 6}).Mag() != 0 {
+		t.Errorf("Dense Col(2) = %v", got)
+	}
+	if got, expected := a.String(), "[[1, 2, 3], [4, 5, 6]]"; got != expected {
+		t.Errorf("Dense String() = %q, want %q", got, expected)
+	}
+
+	c := a.Clone()
+	c.Set(0, 0, 100)
+	if a.At(0, 0) != 1 || c.At(0, 0) != 100 {
+		t.Errorf("Dense Clone() shares elements with the original")
+	}
+}
+
+// TestDenseInverse tests the determinant and inverse of a 6x6 matrix and of a singular matrix.
+func TestDenseInverse(t *testing.T) {
+	a := NewDense[float64](6, 6, nil)
+	for i := 0; i < 6; i++ {
+		for j := 0; j < 6; j++ {
+			a.Set(i, j, 1/float64(i+j+1))
+		}
+		a.Set(i, i, a.At(i, i)+1)
+	}
+
+	inv, ok := a.Inverse()
+	if !ok {
+		t.Fatalf("Dense Inverse() reported a singular matrix")
+	}
+	if got := a.Mul(inv); !denseApproxEqual(got, IdentityDense[float64](6), 1e-9) {
+		t.Errorf("Dense Mul(Inverse()) = %v, want identity", got)
+	}
+	if got := a.Determinant() * inv.Determinant(); !approxEqual(got, 1, 1e-9) {
+		t.Errorf("Dense det(A) * det(A^-1) = %v, want 1", got)
+	}
+
+	m := Mat4[float64]{{2, 0, 1, 0}, {1, 3, 0, 0}, {0, 1, 4, 1}, {0, 0, 1, 5}}
+	if got, expected := DenseFromMat4(m).Determinant(), m.Determinant(); !approxEqual(got, expected, 1e-9) {
+		t.Errorf("Dense Determinant() = %v, want %v", got, expected)
+	}
+
+	singular := NewDense(3, 3, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	if _, ok := singular.Inverse(); ok {
+		t.Errorf("Dense Inverse() of a singular matrix reported success")
+	}
+	if got := singular.Determinant(); !approxEqual(got, 0, 1e-12) {
+		t.Errorf("Dense Determinant() of a singular matrix = %v, want 0", got)
+	}
+}
+
+// TestDenseIntegerInverse tests that integer determinants, inverses and solutions are exact, and that a non-integral
+// inverse or solution is reported instead of truncated.
+func TestDenseIntegerInverse(t *testing.T) {
+	dets := []struct {
+		name     string
+		d        Dense[int]
+		expected int
+	}{
+		{"2x2", NewDense(2, 2, []int{2, 1, 1, 3}), 5},
+		{"3x3", NewDense(3, 3, []int{2, 1, 0, 1, 3, 1, 0, 1, 4}), 18},
+		{"zero pivot", NewDense(3, 3, []int{0, 1, 2, 1, 0, 3, 4, -3, 8}), -2},
+		{"singular", NewDense(3, 3, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}), 0},
+		{"Mat4", DenseFromMat4(Mat4[int]{{2, 0, 1, 0}, {1, 3, 0, 0}, {0, 1, 4, 1}, {0, 0, 1, 5}}), 119},
+	}
+	for _, tt := range dets {
+		if got := tt.d.Determinant(); got != tt.expected {
+			t.Errorf("%s Dense[int] Determinant() = %v, want %v", tt.name, got, tt.expected)
+		}
+	}
+
+	a := NewDense(3, 3, []int{1, 2, 3, 0, 1, 4, 5, 6, 0})
+	inv, ok := a.Inverse()
+	if expected := NewDense(3, 3, []int{-24, 18, 5, 20, -15, -4, -5, 4, 1}); !ok || inv.String() != expected.String() {
+		t.Errorf("Dense[int] Inverse() = %v, %v, want %v, true", inv, ok, expected)
+	}
+	if x, err := a.Solve(Vector[int]{6, 5, 11}); err != nil || x.String() != "[1, 1, 1]" {
+		t.Errorf("Dense[int] Solve() = %v, %v, want [1, 1, 1], nil", x, err)
+	}
+
+	b := NewDense(2, 2, []int{2, 1, 1, 3})
+	if got, ok := b.Inverse(); ok {
+		t.Errorf("Dense[int] Inverse() with a non-integer inverse = %v, true, want false", got)
+	}
+	if _, err := b.InverseE(); !errors.Is(err, ErrSingular) {
+		t.Errorf("Dense[int] InverseE() error = %v, want %v", err, ErrSingular)
+	}
+	if _, err := b.Solve(Vector[int]{1, 1}); !errors.Is(err, ErrSingular) {
+		t.Errorf("Dense[int] Solve() with a non-integer solution error = %v, want %v", err, ErrSingular)
+	}
+}
+
+// TestDenseConversions tests round trips between dense and fixed-size matrices and vectors.
+func TestDenseConversions(t *testing.T) {
+	m2 := Mat2[int]{{1, 2}, {3, 4}}
+	if got := DenseFromMat2(m2).Mat2(); got != m2 {
+		t.Errorf("DenseFromMat2().Mat2() = %v, want %v", got, m2)
+	}
+	m3 := Mat3[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	if got := DenseFromMat3(m3).Mat3(); got != m3 {
+		t.Errorf("DenseFromMat3().Mat3() = %v, want %v", got, m3)
+	}
+	m4 := Mat4[int]{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10, 11, 12}, {13, 14, 15, 16}}
+	if got := DenseFromMat4(m4).Mat4(); got != m4 {
+		t.Errorf("DenseFromMat4().Mat4() = %v, want %v", got, m4)
+	}
+	if got, expected := DenseFromMat3(m3).MulVec(VectorFromVec3(Vec3[int]{1, 0, 2})).Vec3(), m3.MulVec(Vec3[int]{1, 0, 2}); got != expected {
+		t.Errorf("Dense MulVec().Vec3() = %v, want %v", got, expected)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Dense Mat2() of a 3x3 matrix did not panic")
+		}
+	}()
+	DenseFromMat3(m3).Mat2()
+}
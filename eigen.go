@@ -0,0 +1,365 @@
+package bm
+
+import (
+	"math"
+)
+
+const (
+	// jacobiMaxSweeps bounds the number of cyclic Jacobi sweeps; 3x3 matrices converge in well under ten.
+	jacobiMaxSweeps = 50
+	// tql2MaxIterations bounds the QL iterations spent on a single eigenvalue.
+	tql2MaxIterations = 30
+)
+
+/**
+ * EigenSym returns the eigenvalues of the symmetric matrix in ascending order and the matching unit eigenvectors as
+ * the columns of the returned matrix, computed with a Jacobi rotation. Only the lower triangle of m is read.
+ * For example:
+ *   Mat2[float64]{ {2, 1}, {1, 2} }.EigenSym() returns (Vec2[float64]{1, 3}, Mat2[float64]{ {-0.7071, 0.7071}, {0.7071, 0.7071} }) up to signs
+ */
+func (m Mat2[T]) EigenSym() (Vec2[T], Mat2[T]) {
+	a := []float64{
+		float64(m[0][0]), float64(m[1][0]),
+		float64(m[1][0]), float64(m[1][1]),
+	}
+	vals, vecs := jacobiEigen(a, 2)
+	var v Mat2[T]
+	for i := range v {
+		for j := range v[i] {
+			v[i][j] = T(vecs[i*2+j])
+		}
+	}
+	return Vec2[T]{X: T(vals[0]), Y: T(vals[1])}, v
+}
+
+/**
+ * EigenSym returns the eigenvalues of the symmetric matrix in ascending order and the matching unit eigenvectors as
+ * the columns of the returned matrix, computed with cyclic Jacobi rotations. Only the lower triangle of m is read.
+ * The eigenvectors form a rotation or a reflection, so they can be used directly as OBB axes.
+ * For example:
+ *   Mat3[float64]{ {2, 0, 0}, {0, 3, 4}, {0, 4, 9} }.EigenSym() returns (Vec3[float64]{1, 2, 11}, the eigenvectors as columns)
+ */
+func (m Mat3[T]) EigenSym() (Vec3[T], Mat3[T]) {
+	a := make([]float64, 9)
+	for i := 0; i < 3; i++ {
+		for j := 0; j <= i; j++ {
+			a[i*3+j] = float64(m[i][j])
+			a[j*3+i] = float64(m[i][j])
+		}
+	}
+	vals, vecs := jacobiEigen(a, 3)
+	var v Mat3[T]
+	for i := range v {
+		for j := range v[i] {
+			v[i][j] = T(vecs[i*3+j])
+		}
+	}
+	return Vec3[T]{X: T(vals[0]), Y: T(vals[1]), Z: T(vals[2])}, v
+}
+
+/**
+ * EigenSym returns the eigenvalues of the symmetric matrix in ascending order and the matching unit eigenvectors as
+ * the columns of the returned matrix, using Householder tridiagonalization followed by implicit QL iteration.
+ * Only the lower triangle of d is read. It returns ErrNoConvergence if an eigenvalue does not converge and panics
+ * if the matrix is not square.
+ * For example:
+ *   NewDense(2, 2, []float64{2, 1, 1, 2}).EigenSym() returns (Vector[float64]{1, 3}, the eigenvectors as columns, nil)
+ */
+func (d Dense[T]) EigenSym() (Vector[T], Dense[T], error) {
+	d.mustSquare()
+	n := d.rows
+	v := make([][]float64, n)
+	for i := range v {
+		v[i] = make([]float64, n)
+		for j := 0; j <= i; j++ {
+			v[i][j] = float64(d.data[i*n+j])
+			v[j][i] = v[i][j]
+		}
+	}
+	vals := make([]float64, n)
+	off := make([]float64, n)
+	if n > 0 {
+		tred2(v, vals, off)
+		if !tql2(v, vals, off) {
+			return nil, Dense[T]{}, ErrNoConvergence
+		}
+	}
+
+	values := make(Vector[T], n)
+	vectors := NewDense[T](n, n, nil)
+	for i := 0; i < n; i++ {
+		values[i] = T(vals[i])
+		for j := 0; j < n; j++ {
+			vectors.data[i*n+j] = T(v[i][j])
+		}
+	}
+	return values, vectors, nil
+}
+
+// jacobiEigen diagonalizes the symmetric n x n row-major matrix a with cyclic Jacobi rotations. It returns the
+// eigenvalues in ascending order and the eigenvectors as the columns of a row-major matrix.
+func jacobiEigen(a []float64, n int) ([]float64, []float64) {
+	v := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		v[i*n+i] = 1
+	}
+	var frob float64
+	for _, x := range a {
+		frob += x * x
+	}
+
+	for sweep := 0; sweep < jacobiMaxSweeps; sweep++ {
+		var off float64
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				off += a[p*n+q] * a[p*n+q]
+			}
+		}
+		if off <= 1e-30*frob {
+			break
+		}
+
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				apq := a[p*n+q]
+				if apq == 0 {
+					continue
+				}
+				// Choose the smaller rotation angle that zeroes a[p][q].
+				theta := (a[q*n+q] - a[p*n+p]) / (2 * apq)
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+
+				for k := 0; k < n; k++ {
+					akp, akq := a[k*n+p], a[k*n+q]
+					a[k*n+p] = c*akp - s*akq
+					a[k*n+q] = s*akp + c*akq
+				}
+				for k := 0; k < n; k++ {
+					apk, aqk := a[p*n+k], a[q*n+k]
+					a[p*n+k] = c*apk - s*aqk
+					a[q*n+k] = s*apk + c*aqk
+				}
+				for k := 0; k < n; k++ {
+					vkp, vkq := v[k*n+p], v[k*n+q]
+					v[k*n+p] = c*vkp - s*vkq
+					v[k*n+q] = s*vkp + c*vkq
+				}
+			}
+		}
+	}
+
+	vals := make([]float64, n)
+	for i := range vals {
+		vals[i] = a[i*n+i]
+	}
+	sortEigen(vals, func(i, j int) {
+		for k := 0; k < n; k++ {
+			v[k*n+i], v[k*n+j] = v[k*n+j], v[k*n+i]
+		}
+	})
+	return vals, v
+}
+
+// sortEigen sorts the eigenvalues in ascending order by selection sort, calling swap to exchange the matching eigenvectors.
+func sortEigen(vals []float64, swap func(i, j int)) {
+	for i := 0; i < len(vals)-1; i++ {
+		k := i
+		for j := i + 1; j < len(vals); j++ {
+			if vals[j] < vals[k] {
+				k = j
+			}
+		}
+		if k != i {
+			vals[i], vals[k] = vals[k], vals[i]
+			swap(i, k)
+		}
+	}
+}
+
+// tred2 reduces the symmetric matrix v to tridiagonal form by Householder similarity transforms, leaving the diagonal
+// in d, the subdiagonal in e[1:] and the accumulated transformation in v. It follows the EISPACK routine of the same name.
+func tred2(v [][]float64, d, e []float64) {
+	n := len(v)
+	copy(d, v[n-1])
+
+	for i := n - 1; i > 0; i-- {
+		var scale, h float64
+		for k := 0; k < i; k++ {
+			scale += math.Abs(d[k])
+		}
+		if scale == 0 {
+			e[i] = d[i-1]
+			for j := 0; j < i; j++ {
+				d[j] = v[i-1][j]
+				v[i][j] = 0
+				v[j][i] = 0
+			}
+			d[i] = h
+			continue
+		}
+
+		for k := 0; k < i; k++ {
+			d[k] /= scale
+			h += d[k] * d[k]
+		}
+		f := d[i-1]
+		g := math.Sqrt(h)
+		if f > 0 {
+			g = -g
+		}
+		e[i] = scale * g
+		h -= f * g
+		d[i-1] = f - g
+		for j := 0; j < i; j++ {
+			e[j] = 0
+		}
+
+		for j := 0; j < i; j++ {
+			f = d[j]
+			v[j][i] = f
+			g = e[j] + v[j][j]*f
+			for k := j + 1; k <= i-1; k++ {
+				g += v[k][j] * d[k]
+				e[k] += v[k][j] * f
+			}
+			e[j] = g
+		}
+		f = 0
+		for j := 0; j < i; j++ {
+			e[j] /= h
+			f += e[j] * d[j]
+		}
+		hh := f / (h + h)
+		for j := 0; j < i; j++ {
+			e[j] -= hh * d[j]
+		}
+		for j := 0; j < i; j++ {
+			f = d[j]
+			g = e[j]
+			for k := j; k <= i-1; k++ {
+				v[k][j] -= f*e[k] + g*d[k]
+			}
+			d[j] = v[i-1][j]
+			v[i][j] = 0
+		}
+		d[i] = h
+	}
+
+	// Accumulate the transformations.
+	for i := 0; i < n-1; i++ {
+		v[n-1][i] = v[i][i]
+		v[i][i] = 1
+		h := d[i+1]
+		if h != 0 {
+			for k := 0; k <= i; k++ {
+				d[k] = v[k][i+1] / h
+			}
+			for j := 0; j <= i; j++ {
+				var g float64
+				for k := 0; k <= i; k++ {
+					g += v[k][i+1] * v[k][j]
+				}
+				for k := 0; k <= i; k++ {
+					v[k][j] -= g * d[k]
+				}
+			}
+		}
+		for k := 0; k <= i; k++ {
+			v[k][i+1] = 0
+		}
+	}
+	for j := 0; j < n; j++ {
+		d[j] = v[n-1][j]
+		v[n-1][j] = 0
+	}
+	v[n-1][n-1] = 1
+	e[0] = 0
+}
+
+// tql2 finds the eigenvalues and eigenvectors of the tridiagonal matrix produced by tred2 with implicit QL iteration,
+// sorting them in ascending order. It reports false if an eigenvalue fails to converge. It follows the EISPACK routine
+// of the same name.
+func tql2(v [][]float64, d, e []float64) bool {
+	n := len(v)
+	for i := 1; i < n; i++ {
+		e[i-1] = e[i]
+	}
+	e[n-1] = 0
+
+	var f, tst1 float64
+	eps := math.Pow(2, -52)
+	for l := 0; l < n; l++ {
+		tst1 = math.Max(tst1, math.Abs(d[l])+math.Abs(e[l]))
+		m := l
+		for m < n-1 && math.Abs(e[m]) > eps*tst1 {
+			m++
+		}
+
+		if m > l {
+			for iter := 0; ; iter++ {
+				if iter == tql2MaxIterations {
+					return false
+				}
+				// Compute the implicit shift.
+				g := d[l]
+				p := (d[l+1] - g) / (2 * e[l])
+				r := math.Hypot(p, 1)
+				if p < 0 {
+					r = -r
+				}
+				d[l] = e[l] / (p + r)
+				d[l+1] = e[l] * (p + r)
+				dl1 := d[l+1]
+				h := g - d[l]
+				for i := l + 2; i < n; i++ {
+					d[i] -= h
+				}
+				f += h
+
+				// Implicit QL transformation.
+				p = d[m]
+				c, c2, c3 := 1.0, 1.0, 1.0
+				el1 := e[l+1]
+				var s, s2 float64
+				for i := m - 1; i >= l; i-- {
+					c3 = c2
+					c2 = c
+					s2 = s
+					g = c * e[i]
+					h = c * p
+					r = math.Hypot(p, e[i])
+					e[i+1] = s * r
+					s = e[i] / r
+					c = p / r
+					p = c*d[i] - s*g
+					d[i+1] = h + s*(c*g+s*d[i])
+					for k := 0; k < n; k++ {
+						h = v[k][i+1]
+						v[k][i+1] = s*v[k][i] + c*h
+						v[k][i] = c*v[k][i] - s*h
+					}
+				}
+				p = -s * s2 * c3 * el1 * e[l] / dl1
+				e[l] = s * p
+				d[l] = c * p
+				if math.Abs(e[l]) <= eps*tst1 {
+					break
+				}
+			}
+		}
+		d[l] += f
+		e[l] = 0
+	}
+
+	sortEigen(d, func(i, j int) {
+		for k := 0; k < n; k++ {
+			v[k][i], v[k][j] = v[k][j], v[k][i]
+		}
+	})
+	return true
+}
@@ -0,0 +1,86 @@
+package bm
+
+import (
+	"math"
+	"testing"
+)
+
+// TestMat3EigenSym tests that the Jacobi eigenvectors diagonalize a symmetric matrix.
+func TestMat3EigenSym(t *testing.T) {
+	tests := []struct {
+		name   string
+		m      Mat3[float64]
+		values Vec3[float64]
+	}{
+		{"diagonal", Mat3[float64]{{3, 0, 0}, {0, 1, 0}, {0, 0, 2}}, Vec3[float64]{1, 2, 3}},
+		{"block", Mat3[float64]{{2, 0, 0}, {0, 3, 4}, {0, 4, 9}}, Vec3[float64]{1, 2, 11}},
+		{"repeated", Mat3[float64]{{2, 1, 1}, {1, 2, 1}, {1, 1, 2}}, Vec3[float64]{1, 1, 4}},
+		{"inertia tensor", Mat3[float64]{{4, -1, 0.5}, {-1, 5, -2}, {0.5, -2, 6}}, Vec3[float64]{}},
+	}
+	for _, tt := range tests {
+		values, vectors := tt.m.EigenSym()
+		if tt.values != (Vec3[float64]{}) && !vec3ApproxEqual(values, tt.values, 1e-12) {
+			t.Errorf("%s: EigenSym() values = %v, want %v", tt.name, values, tt.values)
+		}
+		if values.X > values.Y || values.Y > values.Z {
+			t.Errorf("%s: EigenSym() values = %v, want ascending order", tt.name, values)
+		}
+		if !mat3ApproxEqual(vectors.Transpose().Mul(vectors), IdentityMat3[float64](), 1e-12) {
+			t.Errorf("%s: EigenSym() vectors = %v, want orthonormal columns", tt.name, vectors)
+		}
+		diag := Mat3[float64]{{values.X, 0, 0}, {0, values.Y, 0}, {0, 0, values.Z}}
+		if got := vectors.Mul(diag).Mul(vectors.Transpose()); !mat3ApproxEqual(got, tt.m, 1e-12) {
+			t.Errorf("%s: V*D*Vᵀ = %v, want %v", tt.name, got, tt.m)
+		}
+	}
+}
+
+// TestMat2EigenSym tests the 2x2 Jacobi eigen-decomposition.
+func TestMat2EigenSym(t *testing.T) {
+	values, vectors := Mat2[float64]{{2, 1}, {1, 2}}.EigenSym()
+	if !approxEqual(values.X, 1, 1e-12) || !approxEqual(values.Y, 3, 1e-12) {
+		t.Errorf("Mat2 EigenSym() values = %v, want {1 3}", values)
+	}
+	if v := vectors.VecMul(Vec2[float64]{1, 1}.Norm()); !approxEqual(math.Abs(v.Y), 1, 1e-12) {
+		t.Errorf("Mat2 EigenSym() vectors = %v, want the second column along {1 1}", vectors)
+	}
+}
+
+// TestDenseEigenSym tests the tridiagonal QL eigensolver against the Jacobi solver and on a larger matrix.
+func TestDenseEigenSym(t *testing.T) {
+	m := Mat3[float64]{{4, -1, 0.5}, {-1, 5, -2}, {0.5, -2, 6}}
+	values, _, err := DenseFromMat3(m).EigenSym()
+	if err != nil {
+		t.Fatalf("Dense EigenSym() error = %v", err)
+	}
+	expected, _ := m.EigenSym()
+	if !vec3ApproxEqual(values.Vec3(), expected, 1e-12) {
+		t.Errorf("Dense EigenSym() values = %v, want %v", values, expected)
+	}
+
+	// The second-difference matrix has the eigenvalues 2 - 2cos(kπ/(n+1)).
+	n := 8
+	a := NewDense[float64](n, n, nil)
+	for i := 0; i < n; i++ {
+		a.Set(i, i, 2)
+		if i > 0 {
+			a.Set(i, i-1, -1)
+			a.Set(i-1, i, -1)
+		}
+	}
+	values, vectors, err := a.EigenSym()
+	if err != nil {
+		t.Fatalf("Dense EigenSym() error = %v", err)
+	}
+	for k := 0; k < n; k++ {
+		if want := 2 - 2*math.Cos(float64(k+1)*math.Pi/float64(n+1)); !approxEqual(values[k], want, 1e-12) {
+			t.Errorf("Dense EigenSym() value %d = %v, want %v", k, values[k], want)
+		}
+		if got := a.MulVec(vectors.Col(k)).Sub(vectors.Col(k).Scale(values[k])).Mag(); got > 1e-12 {
+			t.Errorf("Dense EigenSym() |A*v - λ*v| = %v for eigenvalue %d", got, k)
+		}
+	}
+	if !denseApproxEqual(vectors.Transpose().Mul(vectors), IdentityDense[float64](n), 1e-12) {
+		t.Errorf("Dense EigenSym() vectors are not orthonormal")
+	}
+}
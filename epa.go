@@ -0,0 +1,263 @@
+package bm
+
+// epaFace is a triangle of the EPA polytope with its outward unit normal and distance from the origin.
+type epaFace struct {
+	i, j, k int
+	n       Vec3[float64]
+	d       float64
+}
+
+// Contact2 describes the penetration of two overlapping 2D shapes A and B. Normal is the unit direction from A
+// towards B; moving B by Normal.Scale(Depth) separates the shapes. PointA and PointB are the deepest points of
+// each shape inside the other.
+type Contact2[T Numeric] struct {
+	Normal         Vec2[T]
+	Depth          T
+	PointA, PointB Vec2[T]
+}
+
+/**
+ * EPA3 returns the penetration depth, contact normal and contact points of the convex shapes a and b using the
+ * Expanding Polytope Algorithm, and a boolean that is false if the shapes do not overlap or only touch.
+ * For example:
+ *   EPA3[float64](NewSphere(Vec3[float64]{}, 1), NewSphere(Vec3[float64]{1.5, 0, 0}, 1))
+ *   returns (Contact3[float64]{Normal: {1, 0, 0}, Depth: 0.5, PointA: {1, 0, 0}, PointB: {0.5, 0, 0}}, true) up to the polytope tolerance
+ */
+func EPA3[T Numeric](a, b Support3[T]) (Contact3[T], bool) {
+	r := gjk(a, b)
+	if !r.overlap {
+		return Contact3[T]{}, false
+	}
+	verts, ok := epaTetrahedron(a, b, r.simplex)
+	if !ok {
+		return Contact3[T]{}, false
+	}
+
+	centroid := verts[0].p.Add(verts[1].p).Add(verts[2].p).Add(verts[3].p).Scale(0.25)
+	makeFace := func(i, j, k int) epaFace {
+		n := verts[j].p.Sub(verts[i].p).Cross(verts[k].p.Sub(verts[i].p)).Norm()
+		if n.Dot(verts[i].p.Sub(centroid)) < 0 {
+			j, k = k, j
+			n = n.Neg()
+		}
+		return epaFace{i: i, j: j, k: k, n: n, d: n.Dot(verts[i].p)}
+	}
+	faces := []epaFace{makeFace(0, 1, 2), makeFace(0, 1, 3), makeFace(0, 2, 3), makeFace(1, 2, 3)}
+
+	var closest epaFace
+	for iter := 0; iter < gjkMaxIterations; iter++ {
+		closest = faces[0]
+		for _, f := range faces[1:] {
+			if f.d < closest.d {
+				closest = f
+			}
+		}
+
+		w := gjkSupport(a, b, closest.n)
+		if w.p.Dot(closest.n)-closest.d <= gjkEpsilon*Max(1, closest.d) {
+			break
+		}
+		verts = append(verts, w)
+		newIdx := len(verts) - 1
+
+		// Remove every face the new vertex can see and stitch the hole's horizon to the new vertex.
+		type edge struct{ a, b int }
+		var horizon []edge
+		addEdge := func(e edge) {
+			for i, h := range horizon {
+				if h.a == e.b && h.b == e.a {
+					horizon = append(horizon[:i], horizon[i+1:]...)
+					return
+				}
+			}
+			horizon = append(horizon, e)
+		}
+		kept := faces[:0]
+		for _, f := range faces {
+			if f.n.Dot(w.p.Sub(verts[f.i].p)) > 0 {
+				addEdge(edge{f.i, f.j})
+				addEdge(edge{f.j, f.k})
+				addEdge(edge{f.k, f.i})
+				continue
+			}
+			kept = append(kept, f)
+		}
+		if len(horizon) == 0 {
+			break
+		}
+		faces = kept
+		for _, e := range horizon {
+			faces = append(faces, makeFace(e.a, e.b, newIdx))
+		}
+	}
+
+	// Express the projection of the origin on the closest face in barycentric coordinates to find the contact points.
+	u, v, w := barycentric(closest.n.Scale(closest.d), verts[closest.i].p, verts[closest.j].p, verts[closest.k].p)
+	pa := verts[closest.i].a.Scale(u).Add(verts[closest.j].a.Scale(v)).Add(verts[closest.k].a.Scale(w))
+	pb := verts[closest.i].b.Scale(u).Add(verts[closest.j].b.Scale(v)).Add(verts[closest.k].b.Scale(w))
+	return Contact3[T]{
+		Normal: fromVec3F64[T](closest.n),
+		Depth:  T(closest.d),
+		PointA: fromVec3F64[T](pa),
+		PointB: fromVec3F64[T](pb),
+	}, true
+}
+
+/**
+ * EPA2 returns the penetration depth, contact normal and contact points of the convex 2D shapes a and b using the
+ * Expanding Polytope Algorithm, and a boolean that is false if the shapes do not overlap or only touch.
+ * For example:
+ *   EPA2[float64](NewCircle(Vec2[float64]{}, 1), AABB2[float64]{Min: {0.5, -1}, Max: {2, 1}})
+ *   returns (Contact2[float64]{Normal: {1, 0}, Depth: 0.5, PointA: {1, 0}, PointB: {0.5, 0}}, true) up to the polygon tolerance
+ */
+func EPA2[T Numeric](a, b Support2[T]) (Contact2[T], bool) {
+	a3, b3 := support2As3[T]{a}, support2As3[T]{b}
+	r := gjk[T](a3, b3)
+	if !r.overlap {
+		return Contact2[T]{}, false
+	}
+	verts, ok := epaTriangle[T](a3, b3, r.simplex)
+	if !ok {
+		return Contact2[T]{}, false
+	}
+
+	// With counter-clockwise vertices, the outward normal of edge (p, q) is (q - p) rotated clockwise.
+	edgeNormal := func(i int) (Vec3[float64], float64) {
+		p, q := verts[i].p, verts[(i+1)%len(verts)].p
+		e := q.Sub(p)
+		n := Vec3[float64]{X: e.Y, Y: -e.X}.Norm()
+		return n, n.Dot(p)
+	}
+
+	var best int
+	var n Vec3[float64]
+	var d float64
+	for iter := 0; iter < gjkMaxIterations; iter++ {
+		best = -1
+		for i := range verts {
+			ni, di := edgeNormal(i)
+			if best < 0 || di < d {
+				best, n, d = i, ni, di
+			}
+		}
+		w := gjkSupport[T](a3, b3, n)
+		if w.p.Dot(n)-d <= gjkEpsilon*Max(1, d) {
+			break
+		}
+		verts = append(verts[:best+1], append([]gjkVertex{w}, verts[best+1:]...)...)
+	}
+
+	p, q := verts[best], verts[(best+1)%len(verts)]
+	_, idx, weights := closestSegmentOrigin(p.p, q.p)
+	ends := [2]gjkVertex{p, q}
+	var pa, pb Vec3[float64]
+	for i, k := range idx {
+		pa = pa.Add(ends[k].a.Scale(weights[i]))
+		pb = pb.Add(ends[k].b.Scale(weights[i]))
+	}
+	return Contact2[T]{
+		Normal: Vec2[T]{X: T(n.X), Y: T(n.Y)},
+		Depth:  T(d),
+		PointA: Vec2[T]{X: T(pa.X), Y: T(pa.Y)},
+		PointB: Vec2[T]{X: T(pb.X), Y: T(pb.Y)},
+	}, true
+}
+
+// epaTetrahedron grows the final GJK simplex into a tetrahedron of the Minkowski difference enclosing the origin.
+// It returns false if the difference is flat, which happens when the shapes only touch.
+func epaTetrahedron[T Numeric](a, b Support3[T], s gjkSimplex) ([]gjkVertex, bool) {
+	verts := append([]gjkVertex(nil), s.verts[:s.n]...)
+	axes := []Vec3[float64]{{X: 1}, {X: -1}, {Y: 1}, {Y: -1}, {Z: 1}, {Z: -1}}
+
+	if len(verts) == 1 {
+		for _, d := range axes {
+			w := gjkSupport(a, b, d)
+			if w.p.Sub(verts[0].p).Mag() > gjkTolerance {
+				verts = append(verts, w)
+				break
+			}
+		}
+	}
+	if len(verts) == 2 {
+		line := verts[1].p.Sub(verts[0].p)
+		for _, axis := range axes {
+			d := line.Cross(axis)
+			if d.Mag() <= gjkTolerance {
+				continue
+			}
+			w := gjkSupport(a, b, d)
+			if w.p.Sub(verts[0].p).Cross(line).Mag() > gjkTolerance {
+				verts = append(verts, w)
+				break
+			}
+		}
+	}
+	if len(verts) == 3 {
+		n := verts[1].p.Sub(verts[0].p).Cross(verts[2].p.Sub(verts[0].p))
+		for _, d := range []Vec3[float64]{n, n.Neg()} {
+			w := gjkSupport(a, b, d)
+			if Abs(w.p.Sub(verts[0].p).Dot(n.Norm())) > gjkTolerance {
+				verts = append(verts, w)
+				break
+			}
+		}
+	}
+	return verts, len(verts) == 4
+}
+
+// epaTriangle grows the final 2D GJK simplex into a counter-clockwise triangle of the Minkowski difference enclosing the origin.
+// It returns false if the difference is flat, which happens when the shapes only touch.
+func epaTriangle[T Numeric](a, b Support3[T], s gjkSimplex) ([]gjkVertex, bool) {
+	verts := append([]gjkVertex(nil), s.verts[:s.n]...)
+	if len(verts) == 1 {
+		for _, d := range []Vec3[float64]{{X: 1}, {X: -1}, {Y: 1}, {Y: -1}} {
+			w := gjkSupport(a, b, d)
+			if w.p.Sub(verts[0].p).Mag() > gjkTolerance {
+				verts = append(verts, w)
+				break
+			}
+		}
+	}
+	if len(verts) == 2 {
+		line := verts[1].p.Sub(verts[0].p)
+		perp := Vec3[float64]{X: -line.Y, Y: line.X}
+		for _, d := range []Vec3[float64]{perp, perp.Neg()} {
+			w := gjkSupport(a, b, d)
+			if Abs(cross2(line, w.p.Sub(verts[0].p))) > gjkTolerance {
+				verts = append(verts, w)
+				break
+			}
+		}
+	}
+	if len(verts) != 3 {
+		return nil, false
+	}
+	if cross2(verts[1].p.Sub(verts[0].p), verts[2].p.Sub(verts[0].p)) < 0 {
+		verts[1], verts[2] = verts[2], verts[1]
+	}
+	return verts, true
+}
+
+// cross2 returns the Z component of the cross product of two vectors in the XY plane.
+func cross2(a, b Vec3[float64]) float64 {
+	return a.X*b.Y - a.Y*b.X
+}
+
+// barycentric returns the barycentric coordinates (u, v, w) of p with respect to the triangle abc.
+func barycentric(p, a, b, c Vec3[float64]) (float64, float64, float64) {
+	v0 := b.Sub(a)
+	v1 := c.Sub(a)
+	v2 := p.Sub(a)
+	d00 := v0.Dot(v0)
+	d01 := v0.Dot(v1)
+	d11 := v1.Dot(v1)
+	d20 := v2.Dot(v0)
+	d21 := v2.Dot(v1)
+	denom := d00*d11 - d01*d01
+	if denom == 0 {
+		return 1, 0, 0
+	}
+	v := (d11*d20 - d01*d21) / denom
+	w := (d00*d21 - d01*d20) / denom
+	return 1 - v - w, v, w
+}
@@ -0,0 +1,29 @@
+package bm
+
+import (
+	"errors"
+)
+
+// ErrSingular is returned when a matrix that must be invertible is numerically singular.
+var ErrSingular = errors.New("bm: matrix is singular")
+
+// ErrNoConvergence is returned when an iterative algorithm does not reach its tolerance within its iteration limit.
+var ErrNoConvergence = errors.New("bm: iteration did not converge")
+
+// ErrNotPositiveDefinite is returned when a factorization requires a symmetric positive-definite matrix and is given another.
+var ErrNotPositiveDefinite = errors.New("bm: matrix is not positive definite")
+
+// ErrDomain is returned when a function is evaluated outside the domain where it is defined, such as the square root of a negative number.
+var ErrDomain = errors.New("bm: argument outside function domain")
+
+// ErrOutOfRange is returned when a value lies outside the range covered by the data, such as evaluating an interpolant beyond its knots.
+var ErrOutOfRange = errors.New("bm: value out of range")
+
+// ErrLength is returned when input slices are too short or have mismatched lengths.
+var ErrLength = errors.New("bm: invalid input length")
+
+// ErrNotIncreasing is returned when interpolation knots are not strictly increasing.
+var ErrNotIncreasing = errors.New("bm: knots are not strictly increasing")
+
+// ErrKnotVector is returned when a B-spline knot vector is decreasing, repeats a knot too often or does not match the number of control points.
+var ErrKnotVector = errors.New("bm: invalid knot vector")
@@ -0,0 +1,178 @@
+package bm
+
+// DepthRange selects the clip-space depth convention of a projection matrix.
+type DepthRange int
+
+const (
+	// DepthNegOneToOne is the OpenGL convention, with clip-space depth in [-1, 1].
+	DepthNegOneToOne DepthRange = iota
+	// DepthZeroToOne is the Vulkan and Direct3D convention, with clip-space depth in [0, 1]. Reversed-Z projections also use it,
+	// in which case the FrustumNear and FrustumFar planes trade places.
+	DepthZeroToOne
+)
+
+// Containment is the result of testing a shape against a volume.
+type Containment int
+
+const (
+	// Outside means the shape lies entirely outside the volume.
+	Outside Containment = iota
+	// Inside means the shape lies entirely inside the volume.
+	Inside
+	// Intersecting means the shape lies partly inside the volume.
+	Intersecting
+)
+
+/**
+ * String returns the name of the containment result.
+ * For example:
+ *   Intersecting.String() returns "Intersecting"
+ */
+func (c Containment) String() string {
+	switch c {
+	case Outside:
+		return "Outside"
+	case Inside:
+		return "Inside"
+	case Intersecting:
+		return "Intersecting"
+	}
+	return "Containment(?)"
+}
+
+// Indices of the planes in Frustum.Planes.
+const (
+	FrustumLeft = iota
+	FrustumRight
+	FrustumBottom
+	FrustumTop
+	FrustumNear
+	FrustumFar
+)
+
+// Frustum represents a view volume bounded by six planes whose unit normals point into the volume.
+type Frustum[T Numeric] struct {
+	Planes [6]Plane[T]
+}
+
+/**
+ * FrustumFromMat4 extracts the six planes of the view volume of a view-projection matrix, using the given clip-space depth convention.
+ * Planes of projections with an infinite far plane have a zero normal and never reject anything.
+ * For example:
+ *   FrustumFromMat4(Perspective[float64](math.Pi/2, 1, 1, 100).Mul(view), DepthNegOneToOne) returns the world-space view volume
+ */
+func FrustumFromMat4[T Numeric](m Mat4[T], depth DepthRange) Frustum[T] {
+	row := func(i int) Vec4[T] {
+		return Vec4[T]{X: m[i][0], Y: m[i][1], Z: m[i][2], W: m[i][3]}
+	}
+	plane := func(v Vec4[T]) Plane[T] {
+		return Plane[T]{Normal: Vec3[T]{X: v.X, Y: v.Y, Z: v.Z}, D: v.W}.Norm()
+	}
+
+	r0, r1, r2, r3 := row(0), row(1), row(2), row(3)
+	var f Frustum[T]
+	f.Planes[FrustumLeft] = plane(r3.Add(r0))
+	f.Planes[FrustumRight] = plane(r3.Sub(r0))
+	f.Planes[FrustumBottom] = plane(r3.Add(r1))
+	f.Planes[FrustumTop] = plane(r3.Sub(r1))
+	if depth == DepthZeroToOne {
+		f.Planes[FrustumNear] = plane(r2)
+	} else {
+		f.Planes[FrustumNear] = plane(r3.Add(r2))
+	}
+	f.Planes[FrustumFar] = plane(r3.Sub(r2))
+	return f
+}
+
+/**
+ * ContainsPoint reports whether the point p lies inside or on the frustum.
+ * For example:
+ *   FrustumFromMat4(Perspective[float64](math.Pi/2, 1, 1, 3), DepthNegOneToOne).ContainsPoint(Vec3[float64]{0, 0, -2}) returns true
+ */
+func (f Frustum[T]) ContainsPoint(p Vec3[T]) bool {
+	for _, plane := range f.Planes {
+		if plane.SignedDistance(p) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+/**
+ * TestSphere reports whether the sphere lies inside, outside or across the boundary of the frustum.
+ * Spheres near the frustum's corners may be reported as Intersecting although they are outside.
+ * For example:
+ *   FrustumFromMat4(Perspective[float64](math.Pi/2, 1, 1, 3), DepthNegOneToOne).TestSphere(NewSphere(Vec3[float64]{0, 0, -2}, 0.5)) returns Inside
+ */
+func (f Frustum[T]) TestSphere(s Sphere[T]) Containment {
+	result := Inside
+	for _, plane := range f.Planes {
+		d := plane.SignedDistance(s.Center)
+		if d < -s.Radius {
+			return Outside
+		}
+		if d < s.Radius {
+			result = Intersecting
+		}
+	}
+	return result
+}
+
+/**
+ * TestAABB reports whether the box lies inside, outside or across the boundary of the frustum.
+ * Boxes near the frustum's corners may be reported as Intersecting although they are outside.
+ * For example:
+ *   FrustumFromMat4(Perspective[float64](math.Pi/2, 1, 1, 3), DepthNegOneToOne).TestAABB(AABB3[float64]{Min: {5, 5, -2}, Max: {6, 6, -1}}) returns Outside
+ */
+func (f Frustum[T]) TestAABB(b AABB3[T]) Containment {
+	result := Inside
+	for _, plane := range f.Planes {
+		positive, negative := b.Min, b.Max
+		if plane.Normal.X >= 0 {
+			positive.X, negative.X = b.Max.X, b.Min.X
+		}
+		if plane.Normal.Y >= 0 {
+			positive.Y, negative.Y = b.Max.Y, b.Min.Y
+		}
+		if plane.Normal.Z >= 0 {
+			positive.Z, negative.Z = b.Max.Z, b.Min.Z
+		}
+		if plane.SignedDistance(positive) < 0 {
+			return Outside
+		}
+		if plane.SignedDistance(negative) < 0 {
+			result = Intersecting
+		}
+	}
+	return result
+}
+
+/**
+ * CullAABBs appends to dst the indices of the boxes that are not entirely outside the frustum and returns the extended slice.
+ * Passing a reused dst[:0] avoids allocating every frame.
+ * For example:
+ *   visible = frustum.CullAABBs(boxes, visible[:0])
+ */
+func (f Frustum[T]) CullAABBs(boxes []AABB3[T], dst []int) []int {
+	for i, b := range boxes {
+		if f.TestAABB(b) != Outside {
+			dst = append(dst, i)
+		}
+	}
+	return dst
+}
+
+/**
+ * CullSpheres appends to dst the indices of the spheres that are not entirely outside the frustum and returns the extended slice.
+ * Passing a reused dst[:0] avoids allocating every frame.
+ * For example:
+ *   visible = frustum.CullSpheres(spheres, visible[:0])
+ */
+func (f Frustum[T]) CullSpheres(spheres []Sphere[T], dst []int) []int {
+	for i, s := range spheres {
+		if f.TestSphere(s) != Outside {
+			dst = append(dst, i)
+		}
+	}
+	return dst
+}
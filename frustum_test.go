@@ -0,0 +1,72 @@
+package bm
+
+import (
+	"testing"
+)
+
+// TestFrustumFromMat4 tests plane extraction for both depth conventions.
+func TestFrustumFromMat4(t *testing.T) {
+	view := LookAt(NewVec3[float64](0, 0, 10), NewVec3[float64](0, 0, 0), NewVec3[float64](0, 1, 0))
+	tests := []struct {
+		name      string
+		frustum   Frustum[float64]
+		near, far int
+	}{
+		{"OpenGL", FrustumFromMat4(Perspective(Pi/2, 1, 1, 3).Mul(view), DepthNegOneToOne), FrustumNear, FrustumFar},
+		{"reversed-Z", FrustumFromMat4(PerspectiveReversedZ(Pi/2, 1, 1, 3).Mul(view), DepthZeroToOne), FrustumFar, FrustumNear},
+	}
+
+	for _, tt := range tests {
+		name, f := tt.name, tt.frustum
+		if got := f.Planes[tt.near].SignedDistance(NewVec3[float64](0, 0, 9)); !approxEqual(got, 0, 1e-12) {
+			t.Errorf("%s near plane distance = %v, want 0", name, got)
+		}
+		if got := f.Planes[tt.far].SignedDistance(NewVec3[float64](0, 0, 7)); !approxEqual(got, 0, 1e-12) {
+			t.Errorf("%s far plane distance = %v, want 0", name, got)
+		}
+		if !f.ContainsPoint(NewVec3[float64](1.9, -1.9, 8)) {
+			t.Errorf("%s ContainsPoint() = false for a point inside", name)
+		}
+		if f.ContainsPoint(NewVec3[float64](2.1, 0, 8)) {
+			t.Errorf("%s ContainsPoint() = true for a point outside", name)
+		}
+	}
+}
+
+// TestFrustumCull tests sphere and box classification and batch culling.
+func TestFrustumCull(t *testing.T) {
+	f := FrustumFromMat4(Perspective(Pi/2, 1, 1, 3), DepthNegOneToOne)
+
+	spheres := []struct {
+		sphere   Sphere[float64]
+		expected Containment
+	}{
+		{NewSphere(NewVec3[float64](0, 0, -2), 0.5), Inside},
+		{NewSphere(NewVec3[float64](0, 0, -3), 0.5), Intersecting},
+		{NewSphere(NewVec3[float64](0, 0, 0), 0.5), Outside},
+		{NewSphere(NewVec3[float64](5, 0, -2), 1), Outside},
+	}
+	for _, tt := range spheres {
+		if got := f.TestSphere(tt.sphere); got != tt.expected {
+			t.Errorf("Frustum TestSphere(%v) = %v, want %v", tt.sphere, got, tt.expected)
+		}
+	}
+
+	boxes := []AABB3[float64]{
+		{Min: NewVec3[float64](-0.5, -0.5, -2.5), Max: NewVec3[float64](0.5, 0.5, -1.5)},
+		{Min: NewVec3[float64](5, 5, -2), Max: NewVec3[float64](6, 6, -1)},
+		{Min: NewVec3[float64](1, -0.5, -2.5), Max: NewVec3[float64](3, 0.5, -1.5)},
+		{Min: NewVec3[float64](-1, -1, 1), Max: NewVec3[float64](1, 1, 2)},
+	}
+	expected := []Containment{Inside, Outside, Intersecting, Outside}
+	for i, b := range boxes {
+		if got := f.TestAABB(b); got != expected[i] {
+			t.Errorf("Frustum TestAABB(%v) = %v, want %v", b, got, expected[i])
+		}
+	}
+
+	visible := f.CullAABBs(boxes, make([]int, 0, len(boxes)))
+	if len(visible) != 2 || visible[0] != 0 || visible[1] != 2 {
+		t.Errorf("Frustum CullAABBs() = %v, want %v", visible, []int{0, 2})
+	}
+}
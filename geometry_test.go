@@ -0,0 +1,162 @@
+package bm
+
+import (
+	"testing"
+)
+
+// TestPlane tests plane construction, signed distance and projection.
+func TestPlane(t *testing.T) {
+	p := PlaneFromPoints(NewVec3[float64](0, 0, 2), NewVec3[float64](1, 0, 2), NewVec3[float64](0, 1, 2))
+	if expected := NewPlane(NewVec3[float64](0, 0, 1), -2); p != expected {
+		t.Errorf("PlaneFromPoints() = %v, want %v", p, expected)
+	}
+	if got := PlaneFromNormalPoint(NewVec3[float64](0, 0, 5), NewVec3[float64](3, 4, 2)); got != p {
+		t.Errorf("PlaneFromNormalPoint() = %v, want %v", got, p)
+	}
+	if got := (Plane[float64]{Normal: NewVec3[float64](0, 0, 2), D: -4}).Norm(); got != p {
+		t.Errorf("Plane Norm() = %v, want %v", got, p)
+	}
+
+	tests := []struct {
+		point    Vec3[float64]
+		distance float64
+	}{
+		{NewVec3[float64](0, 0, 2), 0},
+		{NewVec3[float64](1, 1, 5), 3},
+		{NewVec3[float64](-1, 7, -1), -3},
+	}
+	for _, tt := range tests {
+		if got := p.SignedDistance(tt.point); got != tt.distance {
+			t.Errorf("Plane SignedDistance(%v) = %v, want %v", tt.point, got, tt.distance)
+		}
+		if got := p.ProjectPoint(tt.point); got.Z != 2 || got.X != tt.point.X || got.Y != tt.point.Y {
+			t.Errorf("Plane ProjectPoint(%v) = %v", tt.point, got)
+		}
+		if got := p.Flip().SignedDistance(tt.point); got != -tt.distance {
+			t.Errorf("Plane Flip().SignedDistance(%v) = %v, want %v", tt.point, got, -tt.distance)
+		}
+	}
+}
+
+// TestLineSegmentClosestPoints tests the closest points between segments in every configuration.
+func TestLineSegmentClosestPoints(t *testing.T) {
+	x := NewLineSegment(NewVec3[float64](-1, 0, 0), NewVec3[float64](1, 0, 0))
+
+	tests := []struct {
+		name   string
+		other  LineSegment[float64]
+		p, q   Vec3[float64]
+		distSq float64
+	}{
+		{"crossing", NewLineSegment(NewVec3[float64](0, -1, 2), NewVec3[float64](0, 1, 2)),
+			NewVec3[float64](0, 0, 0), NewVec3[float64](0, 0, 2), 4},
+		{"endpoint to interior", NewLineSegment(NewVec3[float64](3, -1, 0), NewVec3[float64](3, 1, 0)),
+			NewVec3[float64](1, 0, 0), NewVec3[float64](3, 0, 0), 4},
+		{"endpoint to endpoint", NewLineSegment(NewVec3[float64](2, 1, 0), NewVec3[float64](4, 3, 0)),
+			NewVec3[float64](1, 0, 0), NewVec3[float64](2, 1, 0), 2},
+		{"interior to endpoint", NewLineSegment(NewVec3[float64](0.5, 1, 0), NewVec3[float64](0.5, 3, 0)),
+			NewVec3[float64](0.5, 0, 0), NewVec3[float64](0.5, 1, 0), 1},
+		{"intersecting", NewLineSegment(NewVec3[float64](0.5, -1, 0), NewVec3[float64](0.5, 1, 0)),
+			NewVec3[float64](0.5, 0, 0), NewVec3[float64](0.5, 0, 0), 0},
+		{"parallel", NewLineSegment(NewVec3[float64](2, 1, 0), NewVec3[float64](3, 1, 0)),
+			NewVec3[float64](1, 0, 0), NewVec3[float64](2, 1, 0), 2},
+		{"degenerate", NewLineSegment(NewVec3[float64](0.25, 2, 0), NewVec3[float64](0.25, 2, 0)),
+			NewVec3[float64](0.25, 0, 0), NewVec3[float64](0.25, 2, 0), 4},
+	}
+
+	for _, tt := range tests {
+		p, q := x.ClosestPoints(tt.other)
+		if !vec3ApproxEqual(p, tt.p, 1e-12) || !vec3ApproxEqual(q, tt.q, 1e-12) {
+			t.Errorf("LineSegment ClosestPoints() %s = %v, %v, want %v, %v", tt.name, p, q, tt.p, tt.q)
+		}
+		if d := x.DistanceSegment(tt.other); !approxEqual(d*d, tt.distSq, 1e-12) {
+			t.Errorf("LineSegment DistanceSegment() %s = %v, want squared %v", tt.name, d, tt.distSq)
+		}
+	}
+
+	if got := x.Distance(NewVec3[float64](0, 3, 4)); got != 5 {
+		t.Errorf("LineSegment Distance() = %v, want %v", got, 5)
+	}
+}
+
+// TestTriangleClosestPoint tests the closest point on a triangle for every Voronoi region.
+func TestTriangleClosestPoint(t *testing.T) {
+	tri := NewTriangle(NewVec3[float64](0, 0, 0), NewVec3[float64](2, 0, 0), NewVec3[float64](0, 2, 0))
+
+	tests := []struct {
+		name     string
+		point    Vec3[float64]
+		expected Vec3[float64]
+	}{
+		{"vertex A", NewVec3[float64](-1, -1, 1), NewVec3[float64](0, 0, 0)},
+		{"vertex B", NewVec3[float64](3, -1, 0), NewVec3[float64](2, 0, 0)},
+		{"vertex C", NewVec3[float64](-1, 3, 0), NewVec3[float64](0, 2, 0)},
+		{"edge AB", NewVec3[float64](1, -1, 0), NewVec3[float64](1, 0, 0)},
+		{"edge AC", NewVec3[float64](-1, 1, 0), NewVec3[float64](0, 1, 0)},
+		{"edge BC", NewVec3[float64](2, 2, 0), NewVec3[float64](1, 1, 0)},
+		{"face", NewVec3[float64](0.5, 0.5, -3), NewVec3[float64](0.5, 0.5, 0)},
+	}
+	for _, tt := range tests {
+		if got := tri.ClosestPoint(tt.point); !vec3ApproxEqual(got, tt.expected, 1e-12) {
+			t.Errorf("Triangle ClosestPoint() %s = %v, want %v", tt.name, got, tt.expected)
+		}
+		if got, want := tri.Distance(tt.point), tt.point.Dist(tt.expected); !approxEqual(got, want, 1e-12) {
+			t.Errorf("Triangle Distance() %s = %v, want %v", tt.name, got, want)
+		}
+	}
+
+	if got := tri.Area(); got != 2 {
+		t.Errorf("Triangle Area() = %v, want %v", got, 2)
+	}
+	if got, want := tri.Normal(), NewVec3[float64](0, 0, 1); got != want {
+		t.Errorf("Triangle Normal() = %v, want %v", got, want)
+	}
+}
+
+// TestOverlaps tests the sphere, capsule and OBB overlap queries.
+func TestOverlaps(t *testing.T) {
+	unit := NewSphere(NewVec3[float64](0, 0, 0), 1)
+	rod := NewCapsule(NewVec3[float64](-1, 0, 0), NewVec3[float64](1, 0, 0), 0.5)
+	box := NewOBB(NewVec3[float64](0, 0, 0), IdentityMat3[float64](), NewVec3[float64](1, 1, 1))
+	diamond := NewOBB(NewVec3[float64](0, 0, 0), RotateZ(Pi/4), NewVec3[float64](1, 1, 1))
+
+	tests := []struct {
+		name     string
+		got      bool
+		expected bool
+	}{
+		{"sphere/sphere touching", unit.Overlaps(NewSphere(NewVec3[float64](2, 0, 0), 1)), true},
+		{"sphere/sphere apart", unit.Overlaps(NewSphere(NewVec3[float64](2.1, 0, 0), 1)), false},
+		{"sphere/AABB", unit.OverlapsAABB(AABB3[float64]{Min: NewVec3[float64](0.5, 0.5, 0.5), Max: NewVec3[float64](2, 2, 2)}), true},
+		{"sphere/AABB corner gap", unit.OverlapsAABB(AABB3[float64]{Min: NewVec3[float64](0.7, 0.7, 0.7), Max: NewVec3[float64](2, 2, 2)}), false},
+		{"sphere/capsule side", rod.OverlapsSphere(NewSphere(NewVec3[float64](0.5, 1.4, 0), 1)), true},
+		{"sphere/capsule cap", unit.OverlapsCapsule(NewCapsule(NewVec3[float64](2.6, 0, 0), NewVec3[float64](4, 0, 0), 0.5)), false},
+		{"capsule/capsule crossing", rod.Overlaps(NewCapsule(NewVec3[float64](0, -1, 0.9), NewVec3[float64](0, 1, 0.9), 0.5)), true},
+		{"capsule/capsule apart", rod.Overlaps(NewCapsule(NewVec3[float64](0, -1, 1.1), NewVec3[float64](0, 1, 1.1), 0.5)), false},
+		{"sphere/OBB", diamond.OverlapsSphere(NewSphere(NewVec3[float64](1.8, 0, 0), 0.5)), true},
+		{"sphere/OBB corner gap", NewSphere(NewVec3[float64](1.8, 1.8, 0), 0.5).OverlapsOBB(diamond), false},
+		{"OBB/OBB face", box.Overlaps(NewOBB(NewVec3[float64](1.9, 0, 0), IdentityMat3[float64](), NewVec3[float64](1, 1, 1))), true},
+		{"OBB/OBB rotated", box.Overlaps(NewOBB(NewVec3[float64](2.3, 0, 0), RotateZ(Pi/4), NewVec3[float64](1, 1, 1))), true},
+		{"OBB/OBB rotated apart", box.Overlaps(NewOBB(NewVec3[float64](2.5, 0, 0), RotateZ(Pi/4), NewVec3[float64](1, 1, 1))), false},
+		{"OBB/OBB both rotated", diamond.Overlaps(NewOBB(NewVec3[float64](0, 0, 2.9), RotateX(Pi/4), NewVec3[float64](1, 1, 1))), false},
+		{"OBB contains", diamond.Contains(NewVec3[float64](1.4, 0, 0)), true},
+		{"OBB excludes", diamond.Contains(NewVec3[float64](1, 1, 0)), false},
+		{"capsule contains", rod.Contains(NewVec3[float64](1.3, 0.3, 0)), true},
+		{"sphere contains", unit.Contains(NewVec3[float64](0.6, 0.6, 0.6)), false},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("%s overlap = %v, want %v", tt.name, tt.got, tt.expected)
+		}
+	}
+
+	if got, want := box.ClosestPoint(NewVec3[float64](3, 0.5, -4)), NewVec3[float64](1, 0.5, -1); got != want {
+		t.Errorf("OBB ClosestPoint() = %v, want %v", got, want)
+	}
+	if got, want := unit.ClosestPoint(NewVec3[float64](0, 3, 0)), NewVec3[float64](0, 1, 0); got != want {
+		t.Errorf("Sphere ClosestPoint() = %v, want %v", got, want)
+	}
+	if got := rod.Distance(NewVec3[float64](0, 3, 0)); got != 2.5 {
+		t.Errorf("Capsule Distance() = %v, want %v", got, 2.5)
+	}
+}
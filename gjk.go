@@ -0,0 +1,305 @@
+package bm
+
+const (
+	// gjkMaxIterations bounds the number of support queries made by GJK and EPA.
+	gjkMaxIterations = 64
+	// gjkEpsilon is the relative tolerance at which GJK and EPA consider themselves converged.
+	gjkEpsilon = 1e-10
+	// gjkTolerance is the distance below which GJK treats two shapes as touching.
+	gjkTolerance = 1e-9
+)
+
+// gjkVertex is a point of the Minkowski difference A - B together with the support points of A and B that produced it.
+type gjkVertex struct {
+	p, a, b Vec3[float64]
+}
+
+// gjkSimplex holds up to four vertices of the Minkowski difference and the barycentric weights of the point
+// of the simplex closest to the origin.
+type gjkSimplex struct {
+	verts   [4]gjkVertex
+	weights [4]float64
+	n       int
+}
+
+// gjkResult is the outcome of running GJK on two shapes.
+type gjkResult struct {
+	overlap bool
+	v       Vec3[float64]
+	simplex gjkSimplex
+}
+
+// Contact3 describes the penetration of two overlapping 3D shapes A and B. Normal is the unit direction from A
+// towards B; moving B by Normal.Scale(Depth) separates the shapes. PointA and PointB are the deepest points of
+// each shape inside the other.
+type Contact3[T Numeric] struct {
+	Normal         Vec3[T]
+	Depth          T
+	PointA, PointB Vec3[T]
+}
+
+/**
+ * GJKOverlap3 reports whether the convex shapes a and b intersect or touch, using the Gilbert–Johnson–Keerthi algorithm.
+ * The GJK functions are intended for floating-point types.
+ * For example:
+ *   GJKOverlap3[float64](NewSphere(Vec3[float64]{}, 1), AABB3[float64]{Min: {0.5, 0.5, 0.5}, Max: {2, 2, 2}}) returns true
+ */
+func GJKOverlap3[T Numeric](a, b Support3[T]) bool {
+	return gjk(a, b).overlap
+}
+
+/**
+ * GJKDistance3 returns the distance between the convex shapes a and b together with the closest point on each shape.
+ * If the shapes overlap, the distance is 0.
+ * For example:
+ *   GJKDistance3[float64](NewSphere(Vec3[float64]{}, 1), NewSphere(Vec3[float64]{5, 0, 0}, 1))
+ *   returns (3, Vec3[float64]{1, 0, 0}, Vec3[float64]{4, 0, 0})
+ */
+func GJKDistance3[T Numeric](a, b Support3[T]) (T, Vec3[T], Vec3[T]) {
+	r := gjk(a, b)
+	pa, pb := r.simplex.witness()
+	if r.overlap {
+		return 0, fromVec3F64[T](pa), fromVec3F64[T](pa)
+	}
+	return T(r.v.Mag()), fromVec3F64[T](pa), fromVec3F64[T](pb)
+}
+
+/**
+ * GJKOverlap2 reports whether the convex 2D shapes a and b intersect or touch, using the Gilbert–Johnson–Keerthi algorithm.
+ * For example:
+ *   GJKOverlap2[float64](NewCircle(Vec2[float64]{}, 1), AABB2[float64]{Min: {0.5, 0.5}, Max: {2, 2}}) returns true
+ */
+func GJKOverlap2[T Numeric](a, b Support2[T]) bool {
+	return gjk[T](support2As3[T]{a}, support2As3[T]{b}).overlap
+}
+
+/**
+ * GJKDistance2 returns the distance between the convex 2D shapes a and b together with the closest point on each shape.
+ * If the shapes overlap, the distance is 0.
+ * For example:
+ *   GJKDistance2[float64](NewCircle(Vec2[float64]{}, 1), NewCircle(Vec2[float64]{5, 0}, 1))
+ *   returns (3, Vec2[float64]{1, 0}, Vec2[float64]{4, 0})
+ */
+func GJKDistance2[T Numeric](a, b Support2[T]) (T, Vec2[T], Vec2[T]) {
+	dist, pa, pb := GJKDistance3[T](support2As3[T]{a}, support2As3[T]{b})
+	return dist, Vec2[T]{X: pa.X, Y: pa.Y}, Vec2[T]{X: pb.X, Y: pb.Y}
+}
+
+// support2As3 lifts a 2D shape into the Z = 0 plane so the 3D GJK can be reused for 2D queries.
+type support2As3[T Numeric] struct {
+	shape Support2[T]
+}
+
+func (s support2As3[T]) Support(dir Vec3[T]) Vec3[T] {
+	p := s.shape.Support(Vec2[T]{X: dir.X, Y: dir.Y})
+	return Vec3[T]{X: p.X, Y: p.Y}
+}
+
+// gjk runs the distance variant of GJK, which converges to the point of the Minkowski difference a - b closest to the origin.
+func gjk[T Numeric](a, b Support3[T]) gjkResult {
+	var s gjkSimplex
+	s.verts[0] = gjkSupport(a, b, Vec3[float64]{X: 1})
+	s.weights[0] = 1
+	s.n = 1
+	v := s.verts[0].p
+
+	for i := 0; i < gjkMaxIterations; i++ {
+		vv := v.Dot(v)
+		if vv <= gjkTolerance*gjkTolerance {
+			return gjkResult{overlap: true, v: v, simplex: s}
+		}
+		w := gjkSupport(a, b, v.Neg())
+		if vv-v.Dot(w.p) <= gjkEpsilon*vv || s.contains(w.p) {
+			return gjkResult{v: v, simplex: s}
+		}
+		s.verts[s.n] = w
+		s.n++
+		v = s.closest()
+		if s.n == 4 {
+			return gjkResult{overlap: true, v: v, simplex: s}
+		}
+	}
+	return gjkResult{overlap: v.Dot(v) <= gjkTolerance*gjkTolerance, v: v, simplex: s}
+}
+
+// gjkSupport returns the vertex of the Minkowski difference a - b farthest along d.
+func gjkSupport[T Numeric](a, b Support3[T], d Vec3[float64]) gjkVertex {
+	dt := fromVec3F64[T](d)
+	pa := toVec3F64(a.Support(dt))
+	pb := toVec3F64(b.Support(dt.Neg()))
+	return gjkVertex{p: pa.Sub(pb), a: pa, b: pb}
+}
+
+// contains reports whether p is already a vertex of the simplex.
+func (s *gjkSimplex) contains(p Vec3[float64]) bool {
+	for i := 0; i < s.n; i++ {
+		if s.verts[i].p == p {
+			return true
+		}
+	}
+	return false
+}
+
+// witness returns the points on A and B corresponding to the closest point of the simplex.
+func (s *gjkSimplex) witness() (Vec3[float64], Vec3[float64]) {
+	var pa, pb Vec3[float64]
+	for i := 0; i < s.n; i++ {
+		pa = pa.Add(s.verts[i].a.Scale(s.weights[i]))
+		pb = pb.Add(s.verts[i].b.Scale(s.weights[i]))
+	}
+	return pa, pb
+}
+
+// keep reduces the simplex to the vertices at the given indices with the given weights.
+func (s *gjkSimplex) keep(indices []int, weights []float64) {
+	var verts [4]gjkVertex
+	for i, idx := range indices {
+		verts[i] = s.verts[idx]
+	}
+	s.verts = verts
+	s.weights = [4]float64{}
+	copy(s.weights[:], weights)
+	s.n = len(indices)
+}
+
+// closest reduces the simplex to the smallest sub-simplex containing its point closest to the origin and returns that point.
+// A tetrahedron containing the origin is kept whole and the origin is returned.
+func (s *gjkSimplex) closest() Vec3[float64] {
+	switch s.n {
+	case 1:
+		s.weights[0] = 1
+		return s.verts[0].p
+	case 2:
+		p, idx, w := closestSegmentOrigin(s.verts[0].p, s.verts[1].p)
+		s.keep(idx, w)
+		return p
+	case 3:
+		p, idx, w := closestTriangleOrigin(s.verts[0].p, s.verts[1].p, s.verts[2].p)
+		s.keep(idx, w)
+		return p
+	}
+
+	faces := [4][4]int{{0, 1, 2, 3}, {0, 1, 3, 2}, {0, 2, 3, 1}, {1, 2, 3, 0}}
+	best := -1
+	var bestP Vec3[float64]
+	var bestIdx []int
+	var bestW []float64
+	for f, face := range faces {
+		a, b, c, d := s.verts[face[0]].p, s.verts[face[1]].p, s.verts[face[2]].p, s.verts[face[3]].p
+		n := b.Sub(a).Cross(c.Sub(a))
+		side := n.Dot(d.Sub(a))
+		if side != 0 && n.Dot(a.Neg())*side >= 0 {
+			continue
+		}
+		p, idx, w := closestTriangleOrigin(a, b, c)
+		if best < 0 || p.Dot(p) < bestP.Dot(bestP) {
+			best, bestP, bestW = f, p, w
+			bestIdx = make([]int, len(idx))
+			for i, j := range idx {
+				bestIdx[i] = face[j]
+			}
+		}
+	}
+	if best < 0 {
+		return Vec3[float64]{}
+	}
+	s.keep(bestIdx, bestW)
+	return bestP
+}
+
+// closestSegmentOrigin returns the point of segment ab closest to the origin, the indices of the vertices that
+// support it and their barycentric weights.
+func closestSegmentOrigin(a, b Vec3[float64]) (Vec3[float64], []int, []float64) {
+	ab := b.Sub(a)
+	denom := ab.Dot(ab)
+	if denom == 0 {
+		return a, []int{0}, []float64{1}
+	}
+	t := -a.Dot(ab) / denom
+	if t <= 0 {
+		return a, []int{0}, []float64{1}
+	}
+	if t >= 1 {
+		return b, []int{1}, []float64{1}
+	}
+	return a.Add(ab.Scale(t)), []int{0, 1}, []float64{1 - t, t}
+}
+
+// closestTriangleOrigin returns the point of triangle abc closest to the origin, the indices of the vertices that
+// support it and their barycentric weights.
+func closestTriangleOrigin(a, b, c Vec3[float64]) (Vec3[float64], []int, []float64) {
+	ab := b.Sub(a)
+	ac := c.Sub(a)
+	ap := a.Neg()
+	d1 := ab.Dot(ap)
+	d2 := ac.Dot(ap)
+	if d1 <= 0 && d2 <= 0 {
+		return a, []int{0}, []float64{1}
+	}
+
+	bp := b.Neg()
+	d3 := ab.Dot(bp)
+	d4 := ac.Dot(bp)
+	if d3 >= 0 && d4 <= d3 {
+		return b, []int{1}, []float64{1}
+	}
+
+	vc := d1*d4 - d3*d2
+	if vc <= 0 && d1 >= 0 && d3 <= 0 {
+		t := d1 / (d1 - d3)
+		return a.Add(ab.Scale(t)), []int{0, 1}, []float64{1 - t, t}
+	}
+
+	cp := c.Neg()
+	d5 := ab.Dot(cp)
+	d6 := ac.Dot(cp)
+	if d6 >= 0 && d5 <= d6 {
+		return c, []int{2}, []float64{1}
+	}
+
+	vb := d5*d2 - d1*d6
+	if vb <= 0 && d2 >= 0 && d6 <= 0 {
+		t := d2 / (d2 - d6)
+		return a.Add(ac.Scale(t)), []int{0, 2}, []float64{1 - t, t}
+	}
+
+	va := d3*d6 - d5*d4
+	if va <= 0 && d4-d3 >= 0 && d5-d6 >= 0 {
+		t := (d4 - d3) / ((d4 - d3) + (d5 - d6))
+		return b.Add(c.Sub(b).Scale(t)), []int{1, 2}, []float64{1 - t, t}
+	}
+
+	denom := va + vb + vc
+	if denom == 0 {
+		// Degenerate triangle: fall back to the closest of its edges.
+		verts := [3]Vec3[float64]{a, b, c}
+		edges := [3][2]int{{0, 1}, {1, 2}, {0, 2}}
+		var best Vec3[float64]
+		var bestIdx []int
+		var bestW []float64
+		for i, e := range edges {
+			p, idx, w := closestSegmentOrigin(verts[e[0]], verts[e[1]])
+			if i == 0 || p.Dot(p) < best.Dot(best) {
+				best, bestW = p, w
+				bestIdx = make([]int, len(idx))
+				for j, k := range idx {
+					bestIdx[j] = e[k]
+				}
+			}
+		}
+		return best, bestIdx, bestW
+	}
+	v := vb / denom
+	w := vc / denom
+	return a.Add(ab.Scale(v)).Add(ac.Scale(w)), []int{0, 1, 2}, []float64{1 - v - w, v, w}
+}
+
+// toVec3F64 converts a vector to float64 components.
+func toVec3F64[T Numeric](v Vec3[T]) Vec3[float64] {
+	return Vec3[float64]{X: float64(v.X), Y: float64(v.Y), Z: float64(v.Z)}
+}
+
+// fromVec3F64 converts a float64 vector to components of type T.
+func fromVec3F64[T Numeric](v Vec3[float64]) Vec3[T] {
+	return Vec3[T]{X: T(v.X), Y: T(v.Y), Z: T(v.Z)}
+}
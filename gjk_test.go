@@ -0,0 +1,133 @@
+package bm
+
+import (
+	"math"
+	"testing"
+)
+
+// TestGJK3 tests overlap and distance queries between 3D convex shapes.
+func TestGJK3(t *testing.T) {
+	unitBox := AABB3[float64]{Min: NewVec3[float64](-1, -1, -1), Max: NewVec3[float64](1, 1, 1)}
+	tetra := ConvexHull3[float64]{
+		NewVec3[float64](0, 0, 0), NewVec3[float64](1, 0, 0), NewVec3[float64](0, 1, 0), NewVec3[float64](0, 0, 1),
+	}
+
+	tests := []struct {
+		name     string
+		a, b     Support3[float64]
+		overlap  bool
+		distance float64
+	}{
+		{"separated spheres", NewSphere(NewVec3[float64](0, 0, 0), 1), NewSphere(NewVec3[float64](5, 0, 0), 1), false, 3},
+		{"overlapping spheres", NewSphere(NewVec3[float64](0, 0, 0), 1), NewSphere(NewVec3[float64](1.5, 0, 0), 1), true, 0},
+		{"sphere in box", NewSphere(NewVec3[float64](0, 0, 0), 0.5), unitBox, true, 0},
+		{"box and diagonal sphere", unitBox, NewSphere(NewVec3[float64](3, 3, 1), 1), false, 2*math.Sqrt2 - 1},
+		{"box and capsule", unitBox, NewCapsule(NewVec3[float64](3, -5, 0), NewVec3[float64](3, 5, 0), 0.5), false, 1.5},
+		{"hull and sphere", tetra, NewSphere(NewVec3[float64](1, 1, 1), 0.1), false, 2/math.Sqrt(3) - 0.1},
+		{"rotated OBB", NewOBB(NewVec3[float64](0, 0, 0), RotateZ(math.Pi/4), NewVec3[float64](1, 1, 1)), AABB3[float64]{Min: NewVec3[float64](2, -1, -1), Max: NewVec3[float64](4, 1, 1)}, false, 2 - math.Sqrt2},
+		{"transformed hull", TransformedShape3[float64]{Shape: tetra, Matrix: TranslateMat4(NewVec3[float64](0, 0, 5))}, tetra, false, 4},
+	}
+	for _, tt := range tests {
+		if got := GJKOverlap3(tt.a, tt.b); got != tt.overlap {
+			t.Errorf("%s: GJKOverlap3() = %v, want %v", tt.name, got, tt.overlap)
+		}
+		dist, pa, pb := GJKDistance3(tt.a, tt.b)
+		if !approxEqual(dist, tt.distance, 1e-6) {
+			t.Errorf("%s: GJKDistance3() distance = %v, want %v", tt.name, dist, tt.distance)
+		}
+		if !approxEqual(pa.Sub(pb).Mag(), tt.distance, 1e-6) {
+			t.Errorf("%s: GJKDistance3() points %v and %v are not %v apart", tt.name, pa, pb, tt.distance)
+		}
+	}
+}
+
+// TestGJK2 tests overlap and distance queries between 2D convex shapes.
+func TestGJK2(t *testing.T) {
+	square := ConvexHull2[float64]{
+		NewVec2[float64](-1, -1), NewVec2[float64](1, -1), NewVec2[float64](1, 1), NewVec2[float64](-1, 1),
+	}
+
+	tests := []struct {
+		name     string
+		a, b     Support2[float64]
+		overlap  bool
+		distance float64
+	}{
+		{"separated circles", NewCircle(NewVec2[float64](0, 0), 1), NewCircle(NewVec2[float64](5, 0), 1), false, 3},
+		{"circle and box", NewCircle(NewVec2[float64](0, 0), 1), AABB2[float64]{Min: NewVec2[float64](0.5, 0.5), Max: NewVec2[float64](2, 2)}, true, 0},
+		{"square corner and circle", square, NewCircle(NewVec2[float64](4, 5), 1), false, 4},
+		{"rotated square", TransformedShape2[float64]{Shape: square, Matrix: Rotate2D[float64](math.Pi / 4)}, MinkowskiSum2[float64]{A: square, B: NewCircle(NewVec2[float64](4, 0), 0)}, false, 3 - math.Sqrt2},
+	}
+	for _, tt := range tests {
+		if got := GJKOverlap2(tt.a, tt.b); got != tt.overlap {
+			t.Errorf("%s: GJKOverlap2() = %v, want %v", tt.name, got, tt.overlap)
+		}
+		dist, pa, pb := GJKDistance2(tt.a, tt.b)
+		if !approxEqual(dist, tt.distance, 1e-6) {
+			t.Errorf("%s: GJKDistance2() distance = %v, want %v", tt.name, dist, tt.distance)
+		}
+		if !approxEqual(pa.Sub(pb).Mag(), tt.distance, 1e-6) {
+			t.Errorf("%s: GJKDistance2() points %v and %v are not %v apart", tt.name, pa, pb, tt.distance)
+		}
+	}
+}
+
+// TestEPA3 tests penetration depth and contact normal of overlapping 3D shapes.
+func TestEPA3(t *testing.T) {
+	unitBox := AABB3[float64]{Min: NewVec3[float64](-1, -1, -1), Max: NewVec3[float64](1, 1, 1)}
+
+	tests := []struct {
+		name   string
+		a, b   Support3[float64]
+		normal Vec3[float64]
+		depth  float64
+	}{
+		{"boxes along X", unitBox, AABB3[float64]{Min: NewVec3[float64](0.75, -0.5, -0.5), Max: NewVec3[float64](2, 0.5, 0.5)}, NewVec3[float64](1, 0, 0), 0.25},
+		{"boxes along -Y", unitBox, AABB3[float64]{Min: NewVec3[float64](-0.5, -3, -0.5), Max: NewVec3[float64](0.5, -0.5, 0.5)}, NewVec3[float64](0, -1, 0), 0.5},
+		{"box and sphere", unitBox, NewSphere(NewVec3[float64](0, 0, 1.5), 1), NewVec3[float64](0, 0, 1), 0.5},
+		{"spheres", NewSphere(NewVec3[float64](0, 0, 0), 1), NewSphere(NewVec3[float64](1.5, 0, 0), 1), NewVec3[float64](1, 0, 0), 0.5},
+	}
+	for _, tt := range tests {
+		c, ok := EPA3(tt.a, tt.b)
+		if !ok {
+			t.Errorf("%s: EPA3() reported no overlap", tt.name)
+			continue
+		}
+		if !approxEqual(c.Depth, tt.depth, 1e-3) || !vec3ApproxEqual(c.Normal, tt.normal, 1e-2) {
+			t.Errorf("%s: EPA3() = normal %v depth %v, want normal %v depth %v", tt.name, c.Normal, c.Depth, tt.normal, tt.depth)
+		}
+		if !approxEqual(c.PointA.Sub(c.PointB).Dot(c.Normal), c.Depth, 1e-3) {
+			t.Errorf("%s: EPA3() contact points %v and %v do not span the depth %v", tt.name, c.PointA, c.PointB, c.Depth)
+		}
+	}
+
+	if _, ok := EPA3[float64](unitBox, NewSphere(NewVec3[float64](5, 0, 0), 1)); ok {
+		t.Errorf("EPA3() of separated shapes reported an overlap")
+	}
+}
+
+// TestEPA2 tests penetration depth and contact normal of overlapping 2D shapes.
+func TestEPA2(t *testing.T) {
+	box := AABB2[float64]{Min: NewVec2[float64](-1, -1), Max: NewVec2[float64](1, 1)}
+
+	tests := []struct {
+		name   string
+		a, b   Support2[float64]
+		normal Vec2[float64]
+		depth  float64
+	}{
+		{"circle and box", NewCircle(NewVec2[float64](0, 0), 1), AABB2[float64]{Min: NewVec2[float64](0.5, -1), Max: NewVec2[float64](2, 1)}, NewVec2[float64](1, 0), 0.5},
+		{"boxes along Y", box, AABB2[float64]{Min: NewVec2[float64](-0.5, 0.75), Max: NewVec2[float64](0.5, 3)}, NewVec2[float64](0, 1), 0.25},
+		{"circles", NewCircle(NewVec2[float64](0, 0), 1), NewCircle(NewVec2[float64](0, -1), 1), NewVec2[float64](0, -1), 1},
+	}
+	for _, tt := range tests {
+		c, ok := EPA2(tt.a, tt.b)
+		if !ok {
+			t.Errorf("%s: EPA2() reported no overlap", tt.name)
+			continue
+		}
+		if !approxEqual(c.Depth, tt.depth, 1e-3) || !approxEqual(c.Normal.X, tt.normal.X, 1e-2) || !approxEqual(c.Normal.Y, tt.normal.Y, 1e-2) {
+			t.Errorf("%s: EPA2() = normal %v depth %v, want normal %v depth %v", tt.name, c.Normal, c.Depth, tt.normal, tt.depth)
+		}
+	}
+}
@@ -0,0 +1,471 @@
+package bm
+
+import (
+	"sort"
+)
+
+// Extrapolation selects how an interpolant is evaluated outside the range of its knots.
+type Extrapolation int
+
+const (
+	// ExtrapolateExtend continues the polynomial of the first or last segment.
+	ExtrapolateExtend Extrapolation = iota
+	// ExtrapolateLinear continues along the tangent line at the first or last knot.
+	ExtrapolateLinear
+	// ExtrapolateConstant holds the value at the first or last knot.
+	ExtrapolateConstant
+	// ExtrapolateError treats the interpolant as zero outside its knots, and the E variants of evaluation report
+	// ErrOutOfRange there.
+	ExtrapolateError
+)
+
+/**
+ * String returns the name of the extrapolation policy.
+ * For example:
+ *   ExtrapolateLinear.String() returns "ExtrapolateLinear"
+ */
+func (e Extrapolation) String() string {
+	switch e {
+	case ExtrapolateExtend:
+		return "ExtrapolateExtend"
+	case ExtrapolateLinear:
+		return "ExtrapolateLinear"
+	case ExtrapolateConstant:
+		return "ExtrapolateConstant"
+	case ExtrapolateError:
+		return "ExtrapolateError"
+	}
+	return "Extrapolation(?)"
+}
+
+// Interpolator is a one-dimensional interpolant through samples with strictly increasing x.
+// Spline, LinearInterpolator, PCHIPInterpolator, AkimaInterpolator and NearestInterpolator implement it.
+type Interpolator[T Numeric] interface {
+	// At returns the value at x, following the extrapolation policy outside the knots.
+	At(x T) T
+	// AtE returns the value at x, or ErrOutOfRange outside the knots under ExtrapolateError.
+	AtE(x T) (T, error)
+	// Derivative returns the first derivative at x.
+	Derivative(x T) T
+	// Integrate returns the integral from lo to hi.
+	Integrate(lo, hi T) T
+	// Domain returns the first and last knots.
+	Domain() (T, T)
+}
+
+// LinearInterpolator joins consecutive samples with straight lines.
+type LinearInterpolator[T Numeric] struct {
+	piecewise[T]
+}
+
+// PCHIPInterpolator is the piecewise cubic Hermite interpolant of Fritsch and Carlson. It is monotone wherever the
+// samples are and never overshoots them, at the cost of a discontinuous second derivative.
+type PCHIPInterpolator[T Numeric] struct {
+	piecewise[T]
+}
+
+// AkimaInterpolator is Akima's piecewise cubic interpolant, whose slopes are local weighted averages that suppress the
+// wiggles of a cubic spline near outliers and abrupt changes.
+type AkimaInterpolator[T Numeric] struct {
+	piecewise[T]
+}
+
+// NearestInterpolator returns the sample nearest to the evaluation point, choosing the lower sample at midpoints.
+type NearestInterpolator[T Numeric] struct {
+	piecewise[T]
+}
+
+// piecewise is a piecewise cubic evaluated in float64, shared by the interpolants. Copies share their coefficients.
+type piecewise[T Numeric] struct {
+	x          []float64
+	a, b, c, d []float64 // segment i is a + b*h + c*h² + d*h³ with h = x - x[i]; the last entry describes the end knot
+	integral   []float64 // integral from x[0] to each knot
+	extrap     Extrapolation
+}
+
+/**
+ * NewLinearInterpolator returns the piecewise linear interpolant through the samples (x[i], y[i]). It returns ErrLength
+ * if the slices differ in length or hold fewer than two samples and ErrNotIncreasing if x is not strictly increasing.
+ * For example:
+ *   NewLinearInterpolator([]float64{0, 2}, []float64{1, 5}) returns an interpolator with At(0.5) equal to 2
+ */
+func NewLinearInterpolator[T Numeric](x, y []T) (LinearInterpolator[T], error) {
+	xs, ys, err := checkKnots(x, y)
+	if err != nil {
+		return LinearInterpolator[T]{}, err
+	}
+	p := newPiecewise[T](xs, ys)
+	for i := 0; i < len(xs)-1; i++ {
+		p.b[i] = (ys[i+1] - ys[i]) / (xs[i+1] - xs[i])
+	}
+	p.finish()
+	return LinearInterpolator[T]{p}, nil
+}
+
+/**
+ * NewPCHIPInterpolator returns the monotone piecewise cubic Hermite interpolant through the samples (x[i], y[i]),
+ * with slopes chosen as in SciPy and MATLAB. It returns ErrLength or ErrNotIncreasing as NewLinearInterpolator does.
+ * For example:
+ *   NewPCHIPInterpolator([]float64{0, 1, 2, 3}, []float64{0, 0, 1, 1}) returns an interpolator that stays within [0, 1]
+ */
+func NewPCHIPInterpolator[T Numeric](x, y []T) (PCHIPInterpolator[T], error) {
+	xs, ys, err := checkKnots(x, y)
+	if err != nil {
+		return PCHIPInterpolator[T]{}, err
+	}
+	return PCHIPInterpolator[T]{newHermite[T](xs, ys, pchipSlopes(xs, ys))}, nil
+}
+
+/**
+ * NewAkimaInterpolator returns Akima's piecewise cubic interpolant through the samples (x[i], y[i]).
+ * It returns ErrLength or ErrNotIncreasing as NewLinearInterpolator does.
+ * For example:
+ *   NewAkimaInterpolator([]float64{0, 1, 2, 3, 4}, []float64{0, 0, 0, 1, 1}) returns an interpolator that is flat on [0, 2]
+ */
+func NewAkimaInterpolator[T Numeric](x, y []T) (AkimaInterpolator[T], error) {
+	xs, ys, err := checkKnots(x, y)
+	if err != nil {
+		return AkimaInterpolator[T]{}, err
+	}
+	return AkimaInterpolator[T]{newHermite[T](xs, ys, akimaSlopes(xs, ys))}, nil
+}
+
+/**
+ * NewNearestInterpolator returns the nearest-neighbour interpolant through the samples (x[i], y[i]).
+ * It returns ErrLength or ErrNotIncreasing as NewLinearInterpolator does.
+ * For example:
+ *   NewNearestInterpolator([]float64{0, 1, 2}, []float64{5, 6, 7}) returns an interpolator with At(1.4) equal to 6
+ */
+func NewNearestInterpolator[T Numeric](x, y []T) (NearestInterpolator[T], error) {
+	xs, ys, err := checkKnots(x, y)
+	if err != nil {
+		return NearestInterpolator[T]{}, err
+	}
+	// Each sample holds from the midpoint before it to the midpoint after it.
+	n := len(xs)
+	knots := make([]float64, n+1)
+	knots[0], knots[n] = xs[0], xs[n-1]
+	for i := 1; i < n; i++ {
+		knots[i] = xs[i-1] + (xs[i]-xs[i-1])/2
+	}
+	p := newPiecewise[T](knots, append(ys, ys[n-1]))
+	p.finish()
+	return NearestInterpolator[T]{p}, nil
+}
+
+/**
+ * WithExtrapolation returns a copy of the interpolator that is evaluated outside its knots according to e.
+ * The default is ExtrapolateExtend.
+ * For example:
+ *   l.WithExtrapolation(ExtrapolateConstant).At(x) returns the first or last sample for x outside the knots
+ */
+func (l LinearInterpolator[T]) WithExtrapolation(e Extrapolation) LinearInterpolator[T] {
+	l.extrap = e
+	return l
+}
+
+/**
+ * WithExtrapolation returns a copy of the interpolator that is evaluated outside its knots according to e.
+ * The default is ExtrapolateExtend.
+ * For example:
+ *   p.WithExtrapolation(ExtrapolateConstant).At(x) returns the first or last sample for x outside the knots
+ */
+func (p PCHIPInterpolator[T]) WithExtrapolation(e Extrapolation) PCHIPInterpolator[T] {
+	p.extrap = e
+	return p
+}
+
+/**
+ * WithExtrapolation returns a copy of the interpolator that is evaluated outside its knots according to e.
+ * The default is ExtrapolateExtend.
+ * For example:
+ *   a.WithExtrapolation(ExtrapolateConstant).At(x) returns the first or last sample for x outside the knots
+ */
+func (a AkimaInterpolator[T]) WithExtrapolation(e Extrapolation) AkimaInterpolator[T] {
+	a.extrap = e
+	return a
+}
+
+/**
+ * WithExtrapolation returns a copy of the interpolator that is evaluated outside its knots according to e.
+ * Every policy except ExtrapolateError holds the first or last sample.
+ * For example:
+ *   n.WithExtrapolation(ExtrapolateError).AtE(x) returns (0, ErrOutOfRange) for x outside the knots
+ */
+func (n NearestInterpolator[T]) WithExtrapolation(e Extrapolation) NearestInterpolator[T] {
+	n.extrap = e
+	return n
+}
+
+/**
+ * Extrapolation returns the policy used outside the knots.
+ * For example:
+ *   s.WithExtrapolation(ExtrapolateLinear).Extrapolation() returns ExtrapolateLinear
+ */
+func (p piecewise[T]) Extrapolation() Extrapolation {
+	return p.extrap
+}
+
+/**
+ * Domain returns the first and last knots.
+ * For example:
+ *   an interpolant through samples at x = 0, 1 and 2 has Domain() (0, 2)
+ */
+func (p piecewise[T]) Domain() (T, T) {
+	return T(p.x[0]), T(p.x[len(p.x)-1])
+}
+
+/**
+ * At returns the value of the interpolant at v, following the extrapolation policy outside the knots.
+ * For example:
+ *   NewNaturalSpline([]float64{0, 1, 2}, []float64{0, 1, 0}) gives a spline whose At(1) is 1
+ */
+func (p piecewise[T]) At(v T) T {
+	return T(p.eval(float64(v), 0))
+}
+
+/**
+ * AtE returns the value of the interpolant at v, or ErrOutOfRange if v lies outside the knots and the extrapolation
+ * policy is ExtrapolateError.
+ * For example:
+ *   s.WithExtrapolation(ExtrapolateError).AtE(x) returns (0, ErrOutOfRange) for x outside the knots
+ */
+func (p piecewise[T]) AtE(v T) (T, error) {
+	if p.extrap == ExtrapolateError && outsideKnots(p.x, float64(v)) {
+		return 0, ErrOutOfRange
+	}
+	return p.At(v), nil
+}
+
+/**
+ * Derivative returns the first derivative of the interpolant at v, following the extrapolation policy outside the knots.
+ * For example:
+ *   NewClampedSpline([]float64{0, 1}, []float64{0, 1}, 0, 0) gives a spline whose Derivative(0.5) is 1.5
+ */
+func (p piecewise[T]) Derivative(v T) T {
+	return T(p.eval(float64(v), 1))
+}
+
+/**
+ * SecondDerivative returns the second derivative of the interpolant at v, following the extrapolation policy outside
+ * the knots.
+ * For example:
+ *   a natural spline's SecondDerivative at its first and last knots is 0
+ */
+func (p piecewise[T]) SecondDerivative(v T) T {
+	return T(p.eval(float64(v), 2))
+}
+
+/**
+ * Integrate returns the integral of the interpolant from lo to hi, following the extrapolation policy outside the
+ * knots. The result is negative if hi < lo.
+ * For example:
+ *   NewNotAKnotSpline([]float64{0, 1, 2, 3}, []float64{0, 1, 8, 27}) gives a spline whose Integrate(0, 2) is 4
+ */
+func (p piecewise[T]) Integrate(lo, hi T) T {
+	return T(p.antiderivative(float64(hi)) - p.antiderivative(float64(lo)))
+}
+
+// newPiecewise returns a piecewise constant with the given knots and values, ready for its other coefficients to be
+// filled in before finish is called.
+func newPiecewise[T Numeric](x, y []float64) piecewise[T] {
+	n := len(x)
+	return piecewise[T]{
+		x: x, a: y,
+		b: make([]float64, n), c: make([]float64, n), d: make([]float64, n),
+		integral: make([]float64, n),
+	}
+}
+
+// newHermite returns the piecewise cubic Hermite interpolant with the given slopes at the knots.
+func newHermite[T Numeric](x, y, slopes []float64) piecewise[T] {
+	p := newPiecewise[T](x, y)
+	for i := 0; i < len(x)-1; i++ {
+		h := x[i+1] - x[i]
+		delta := (y[i+1] - y[i]) / h
+		p.b[i] = slopes[i]
+		p.c[i] = (3*delta - 2*slopes[i] - slopes[i+1]) / h
+		p.d[i] = (slopes[i] + slopes[i+1] - 2*delta) / (h * h)
+	}
+	p.finish()
+	return p
+}
+
+// finish fills in the end-knot entry from the last segment and accumulates the integrals at the knots.
+func (p *piecewise[T]) finish() {
+	n := len(p.x)
+	for i := 0; i < n-1; i++ {
+		h := p.x[i+1] - p.x[i]
+		p.integral[i+1] = p.integral[i] + h*(p.a[i]+h*(p.b[i]/2+h*(p.c[i]/3+h*p.d[i]/4)))
+	}
+	i, h := n-2, p.x[n-1]-p.x[n-2]
+	p.b[n-1] = p.b[i] + h*(2*p.c[i]+3*h*p.d[i])
+	p.c[n-1] = p.c[i] + 3*h*p.d[i]
+	p.d[n-1] = 0
+}
+
+// eval returns the value or a derivative of the interpolant at v.
+func (p piecewise[T]) eval(v float64, order int) float64 {
+	n := len(p.x)
+	if outsideKnots(p.x, v) {
+		end := 0
+		if v > p.x[n-1] {
+			end = n - 1
+		}
+		switch p.extrap {
+		case ExtrapolateLinear:
+			return cubicAt(p.a[end], p.b[end], 0, 0, v-p.x[end], order)
+		case ExtrapolateConstant:
+			return cubicAt(p.a[end], 0, 0, 0, v-p.x[end], order)
+		case ExtrapolateError:
+			return 0
+		}
+	}
+	i := findSegment(p.x, v)
+	return cubicAt(p.a[i], p.b[i], p.c[i], p.d[i], v-p.x[i], order)
+}
+
+// antiderivative returns the integral of the interpolant from x[0] to v.
+func (p piecewise[T]) antiderivative(v float64) float64 {
+	n := len(p.x)
+	if outsideKnots(p.x, v) {
+		end := 0
+		if v > p.x[n-1] {
+			end = n - 1
+		}
+		h := v - p.x[end]
+		switch p.extrap {
+		case ExtrapolateLinear:
+			return p.integral[end] + h*(p.a[end]+h*p.b[end]/2)
+		case ExtrapolateConstant:
+			return p.integral[end] + h*p.a[end]
+		case ExtrapolateError:
+			return p.integral[end]
+		}
+	}
+	i := findSegment(p.x, v)
+	h := v - p.x[i]
+	return p.integral[i] + h*(p.a[i]+h*(p.b[i]/2+h*(p.c[i]/3+h*p.d[i]/4)))
+}
+
+// pchipSlopes returns the Fritsch–Carlson slopes: weighted harmonic means of the neighbouring secants in the interior,
+// zero at local extrema, and a shape-preserving three-point estimate at the ends.
+func pchipSlopes(x, y []float64) []float64 {
+	n := len(x)
+	h, delta := secants(x, y)
+	slopes := make([]float64, n)
+	if n == 2 {
+		slopes[0], slopes[1] = delta[0], delta[0]
+		return slopes
+	}
+	for k := 1; k < n-1; k++ {
+		if delta[k-1]*delta[k] <= 0 {
+			continue
+		}
+		w1, w2 := 2*h[k]+h[k-1], h[k]+2*h[k-1]
+		slopes[k] = (w1 + w2) / (w1/delta[k-1] + w2/delta[k])
+	}
+	slopes[0] = pchipEndSlope(h[0], h[1], delta[0], delta[1])
+	slopes[n-1] = pchipEndSlope(h[n-2], h[n-3], delta[n-2], delta[n-3])
+	return slopes
+}
+
+// pchipEndSlope returns the end slope from the nearest secant delta0 and the next secant delta1, limited so that the
+// end segments stay monotone.
+func pchipEndSlope(h0, h1, delta0, delta1 float64) float64 {
+	d := ((2*h0+h1)*delta0 - h0*delta1) / (h0 + h1)
+	switch {
+	case d*delta0 <= 0:
+		return 0
+	case delta0*delta1 <= 0 && Abs(d) > 3*Abs(delta0):
+		return 3 * delta0
+	}
+	return d
+}
+
+// akimaSlopes returns Akima's slopes, averaging the neighbouring secants with weights taken from the changes in the
+// secants on either side. Two extra secants are extrapolated linearly past each end.
+func akimaSlopes(x, y []float64) []float64 {
+	n := len(x)
+	_, delta := secants(x, y)
+	slopes := make([]float64, n)
+	if n == 2 {
+		slopes[0], slopes[1] = delta[0], delta[0]
+		return slopes
+	}
+	// m[k+2] is the secant of segment k.
+	m := make([]float64, n+3)
+	copy(m[2:], delta)
+	m[1] = 2*m[2] - m[3]
+	m[0] = 2*m[1] - m[2]
+	m[n+1] = 2*m[n] - m[n-1]
+	m[n+2] = 2*m[n+1] - m[n]
+	for i := range slopes {
+		w1, w2 := Abs(m[i+3]-m[i+2]), Abs(m[i+1]-m[i])
+		if w1+w2 == 0 {
+			slopes[i] = (m[i+1] + m[i+2]) / 2
+			continue
+		}
+		slopes[i] = (w1*m[i+1] + w2*m[i+2]) / (w1 + w2)
+	}
+	return slopes
+}
+
+// secants returns the widths and slopes of the segments between consecutive samples.
+func secants(x, y []float64) ([]float64, []float64) {
+	h := make([]float64, len(x)-1)
+	delta := make([]float64, len(x)-1)
+	for i := range h {
+		h[i] = x[i+1] - x[i]
+		delta[i] = (y[i+1] - y[i]) / h[i]
+	}
+	return h, delta
+}
+
+// cubicAt returns the value or a derivative of a + b*h + c*h² + d*h³.
+func cubicAt(a, b, c, d, h float64, order int) float64 {
+	switch order {
+	case 1:
+		return b + h*(2*c+3*h*d)
+	case 2:
+		return 2*c + 6*h*d
+	}
+	return a + h*(b+h*(c+h*d))
+}
+
+// checkKnots converts the samples to float64, checking that they match in length, number at least two and have
+// strictly increasing x.
+func checkKnots[T Numeric](x, y []T) ([]float64, []float64, error) {
+	if len(x) != len(y) || len(x) < 2 {
+		return nil, nil, ErrLength
+	}
+	xs, ys := make([]float64, len(x)), make([]float64, len(y))
+	for i := range x {
+		xs[i], ys[i] = float64(x[i]), float64(y[i])
+		if i > 0 && !(xs[i] > xs[i-1]) {
+			return nil, nil, ErrNotIncreasing
+		}
+	}
+	return xs, ys, nil
+}
+
+// findSegment returns the index i of the segment [x[i], x[i+1]] containing v by binary search, clamped to the first and
+// last segments outside the knots.
+func findSegment(x []float64, v float64) int {
+	i := sort.SearchFloat64s(x, v) - 1
+	return Clamp(i, 0, len(x)-2)
+}
+
+// outsideKnots reports whether v lies outside the closed range of the knots.
+func outsideKnots(x []float64, v float64) bool {
+	return v < x[0] || v > x[len(x)-1]
+}
+
+// toT converts a float64 slice to element type T.
+func toT[T Numeric](v []float64) []T {
+	out := make([]T, len(v))
+	for i, x := range v {
+		out[i] = T(x)
+	}
+	return out
+}
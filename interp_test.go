@@ -0,0 +1,171 @@
+package bm
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+var (
+	_ Interpolator[float64] = Spline[float64]{}
+	_ Interpolator[float64] = LinearInterpolator[float64]{}
+	_ Interpolator[float64] = PCHIPInterpolator[float64]{}
+	_ Interpolator[float64] = AkimaInterpolator[float64]{}
+	_ Interpolator[float64] = NearestInterpolator[float64]{}
+)
+
+// interpolators builds every interpolant through the samples.
+func interpolators(t *testing.T, x, y []float64) map[string]Interpolator[float64] {
+	t.Helper()
+	spline, err1 := NewNaturalSpline(x, y)
+	linear, err2 := NewLinearInterpolator(x, y)
+	pchip, err3 := NewPCHIPInterpolator(x, y)
+	akima, err4 := NewAkimaInterpolator(x, y)
+	nearest, err5 := NewNearestInterpolator(x, y)
+	if err := errors.Join(err1, err2, err3, err4, err5); err != nil {
+		t.Fatalf("constructor error = %v", err)
+	}
+	return map[string]Interpolator[float64]{
+		"spline": spline, "linear": linear, "pchip": pchip, "akima": akima, "nearest": nearest,
+	}
+}
+
+// TestInterpolatorsThroughSamples tests that every interpolant passes through its samples and integrates consistently.
+func TestInterpolatorsThroughSamples(t *testing.T) {
+	x := []float64{0, 0.5, 1.5, 2, 3.5, 4}
+	y := []float64{1, -0.5, 2, 2.5, 0, 1}
+	for name, f := range interpolators(t, x, y) {
+		for i, v := range x {
+			if got := f.At(v); !approxEqual(got, y[i], 1e-12) {
+				t.Errorf("%s: At(%v) = %v, want %v", name, v, got, y[i])
+			}
+		}
+		if lo, hi := f.Domain(); lo != 0 || hi != 4 {
+			t.Errorf("%s: Domain() = (%v, %v), want (0, 4)", name, lo, hi)
+		}
+
+		// Compare with the midpoint rule on a fine grid.
+		const steps = 40000
+		var sum float64
+		for k := 0; k < steps; k++ {
+			sum += f.At(4*(float64(k)+0.5)/steps) * 4 / steps
+		}
+		if got := f.Integrate(0, 4); !approxEqual(got, sum, 1e-4) {
+			t.Errorf("%s: Integrate(0, 4) = %v, want about %v", name, got, sum)
+		}
+		if got := f.Integrate(3, 1) + f.Integrate(1, 3); !approxEqual(got, 0, 1e-12) {
+			t.Errorf("%s: Integrate(3, 1) + Integrate(1, 3) = %v, want 0", name, got)
+		}
+	}
+}
+
+// TestLinearAndNearest tests the piecewise linear and nearest-neighbour interpolants.
+func TestLinearAndNearest(t *testing.T) {
+	x := []float64{0, 2, 3}
+	y := []float64{1, 5, 2}
+	linear, _ := NewLinearInterpolator(x, y)
+	nearest, _ := NewNearestInterpolator(x, y)
+	tests := []struct {
+		v                      float64
+		linear, slope, nearest float64
+	}{
+		{0.5, 2, 2, 1},
+		{1, 3, 2, 1},
+		{1.5, 4, 2, 5},
+		{2.4, 3.8, -3, 5},
+		{2.6, 3.2, -3, 2},
+		{-1, -1, 2, 1},
+		{4, -1, -3, 2},
+	}
+	for _, tt := range tests {
+		if got := linear.At(tt.v); !approxEqual(got, tt.linear, 1e-12) {
+			t.Errorf("linear At(%v) = %v, want %v", tt.v, got, tt.linear)
+		}
+		if got := linear.Derivative(tt.v); !approxEqual(got, tt.slope, 1e-12) {
+			t.Errorf("linear Derivative(%v) = %v, want %v", tt.v, got, tt.slope)
+		}
+		if got := nearest.At(tt.v); got != tt.nearest {
+			t.Errorf("nearest At(%v) = %v, want %v", tt.v, got, tt.nearest)
+		}
+	}
+	if got := linear.Integrate(0, 3); !approxEqual(got, 9.5, 1e-12) {
+		t.Errorf("linear Integrate(0, 3) = %v, want 9.5", got)
+	}
+	if got := nearest.Integrate(0, 3); !approxEqual(got, 1*1+5*1.5+2*0.5, 1e-12) {
+		t.Errorf("nearest Integrate(0, 3) = %v, want 9.5", got)
+	}
+	if got := linear.WithExtrapolation(ExtrapolateConstant).At(10); got != 2 {
+		t.Errorf("linear constant extrapolation At(10) = %v, want 2", got)
+	}
+	if _, err := nearest.WithExtrapolation(ExtrapolateError).AtE(-0.1); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("nearest AtE(-0.1) error = %v, want %v", err, ErrOutOfRange)
+	}
+	if got, err := NewNearestInterpolator([]int{0, 10}, []int{3, 7}); err != nil || got.At(4) != 3 || got.At(5) != 3 || got.At(6) != 7 {
+		t.Errorf("integer nearest At(4, 5, 6) = (%v, %v, %v), want (3, 3, 7)", got.At(4), got.At(5), got.At(6))
+	}
+}
+
+// TestShapePreserving tests that PCHIP and Akima avoid the overshoot of a cubic spline on step-like data.
+func TestShapePreserving(t *testing.T) {
+	x := []float64{0, 1, 2, 3, 4, 5}
+	y := []float64{0, 0, 0, 1, 1, 1}
+	spline, _ := NewNaturalSpline(x, y)
+	pchip, _ := NewPCHIPInterpolator(x, y)
+	akima, _ := NewAkimaInterpolator(x, y)
+
+	var splineOvershoot bool
+	prev := math.Inf(-1)
+	for k := 0; k <= 500; k++ {
+		v := 5 * float64(k) / 500
+		if s := spline.At(v); s < -1e-3 || s > 1+1e-3 {
+			splineOvershoot = true
+		}
+		p := pchip.At(v)
+		if p < 0 || p > 1 || p < prev-1e-15 {
+			t.Errorf("pchip At(%v) = %v, want monotone within [0, 1]", v, p)
+		}
+		prev = p
+		if a := akima.At(v); a < -1e-12 || a > 1+1e-12 {
+			t.Errorf("akima At(%v) = %v, want within [0, 1]", v, a)
+		}
+	}
+	if !splineOvershoot {
+		t.Errorf("natural spline did not overshoot, so the data does not exercise shape preservation")
+	}
+	for _, v := range []float64{0.5, 1.5, 3.5, 4.5} {
+		if got := akima.At(v); got != y[int(v)] {
+			t.Errorf("akima At(%v) = %v, want the flat value %v", v, got, y[int(v)])
+		}
+	}
+	if got := pchip.At(2.5); !approxEqual(got, 0.5, 1e-12) {
+		t.Errorf("pchip At(2.5) = %v, want 0.5", got)
+	}
+
+	// PCHIP matches SciPy's PchipInterpolator on nonuniform data.
+	p, _ := NewPCHIPInterpolator([]float64{0, 1, 3, 4}, []float64{0, 1, 1.5, 4})
+	if got := p.Derivative(1); !approxEqual(got, 3.0/7, 1e-12) {
+		t.Errorf("pchip Derivative(1) = %v, want 3/7", got)
+	}
+	if got := p.Derivative(0); !approxEqual(got, 1.25, 1e-12) {
+		t.Errorf("pchip Derivative(0) = %v, want 1.25", got)
+	}
+
+	// Both reproduce straight lines.
+	line := []float64{1, 3, 5, 7, 9, 11}
+	for name, f := range map[string]Interpolator[float64]{
+		"pchip": must(NewPCHIPInterpolator(x, line)),
+		"akima": must(NewAkimaInterpolator(x, line)),
+	} {
+		if got := f.At(2.3); !approxEqual(got, 5.6, 1e-12) {
+			t.Errorf("%s: At(2.3) on a line = %v, want 5.6", name, got)
+		}
+	}
+}
+
+// must returns v, panicking on a non-nil error.
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,195 @@
+package bm
+
+import (
+	"fmt"
+)
+
+// LinearOperator is a matrix that can only be applied to vectors, as required by the iterative solvers.
+// Dense, CSR and CSC all implement it.
+type LinearOperator[T Numeric] interface {
+	Dims() (int, int)
+	MulVec(v Vector[T]) Vector[T]
+}
+
+// Preconditioner approximates the inverse of a matrix to speed up the iterative solvers.
+type Preconditioner[T Numeric] interface {
+	// Apply returns an approximation of A⁻¹ * r.
+	Apply(r Vector[T]) Vector[T]
+}
+
+// Jacobi is the diagonal preconditioner, which divides by the main diagonal of the matrix.
+type Jacobi[T Numeric] struct {
+	inv Vector[T]
+}
+
+/**
+ * NewJacobi returns the Jacobi preconditioner for a matrix with the given main diagonal, as returned by
+ * CSR.Diagonal. Zero entries are treated as ones.
+ * For example:
+ *   NewJacobi(Vector[float64]{2, 4}).Apply(Vector[float64]{1, 1}) returns Vector[float64]{0.5, 0.25}
+ */
+func NewJacobi[T Numeric](diag Vector[T]) Jacobi[T] {
+	inv := make(Vector[T], len(diag))
+	for i, d := range diag {
+		inv[i] = 1
+		if d != 0 {
+			inv[i] /= d
+		}
+	}
+	return Jacobi[T]{inv: inv}
+}
+
+/**
+ * Apply divides r element-wise by the diagonal. It panics if r has the wrong length.
+ * For example:
+ *   NewJacobi(Vector[float64]{2, 4}).Apply(Vector[float64]{1, 1}) returns Vector[float64]{0.5, 0.25}
+ */
+func (p Jacobi[T]) Apply(r Vector[T]) Vector[T] {
+	r.mustLen(len(p.inv))
+	z := make(Vector[T], len(r))
+	for i := range z {
+		z[i] = r[i] * p.inv[i]
+	}
+	return z
+}
+
+/**
+ * ConjugateGradient solves a * x = b for a symmetric positive-definite operator, starting from zero and stopping once
+ * the residual norm falls to tol times the norm of b. The preconditioner m may be nil. If maxIter is not positive,
+ * it defaults to ten times the size of the system. It returns the solution, the number of iterations taken and
+ * ErrNoConvergence, together with the last iterate, if the tolerance is not reached. It panics if a is not square or
+ * b has the wrong length.
+ * For example:
+ *   ConjugateGradient(a, b, NewJacobi(a.Diagonal()), 1e-10, 0) returns (x, iterations, nil) with a.MulVec(x) ≈ b
+ */
+func ConjugateGradient[T Numeric](a LinearOperator[T], b Vector[T], m Preconditioner[T], tol float64, maxIter int) (Vector[T], int, error) {
+	n := mustOperator(a, b)
+	if maxIter <= 0 {
+		maxIter = 10 * n
+	}
+	x := make(Vector[T], n)
+	norm := float64(b.Mag())
+	if norm == 0 {
+		return x, 0, nil
+	}
+	target := tol * norm
+
+	r := b.Clone()
+	z := precondition(m, r)
+	p := z.Clone()
+	rz := r.Dot(z)
+	for iter := 1; iter <= maxIter; iter++ {
+		ap := a.MulVec(p)
+		pap := p.Dot(ap)
+		if pap == 0 {
+			return x, iter, ErrNoConvergence
+		}
+		alpha := rz / pap
+		axpy(x, alpha, p)
+		axpy(r, -alpha, ap)
+		if float64(r.Mag()) <= target {
+			return x, iter, nil
+		}
+		z = precondition(m, r)
+		rzNext := r.Dot(z)
+		beta := rzNext / rz
+		rz = rzNext
+		for i := range p {
+			p[i] = z[i] + beta*p[i]
+		}
+	}
+	return x, maxIter, ErrNoConvergence
+}
+
+/**
+ * BiCGSTAB solves a * x = b for a general square operator with the stabilized biconjugate gradient method, starting
+ * from zero and stopping once the residual norm falls to tol times the norm of b. The preconditioner m may be nil and
+ * is applied on the right. If maxIter is not positive, it defaults to ten times the size of the system. It returns the
+ * solution, the number of iterations taken and ErrNoConvergence, together with the last iterate, if the tolerance is not
+ * reached or the method breaks down. It panics if a is not square or b has the wrong length.
+ * For example:
+ *   BiCGSTAB(a, b, NewJacobi(a.Diagonal()), 1e-10, 0) returns (x, iterations, nil) with a.MulVec(x) ≈ b
+ */
+func BiCGSTAB[T Numeric](a LinearOperator[T], b Vector[T], m Preconditioner[T], tol float64, maxIter int) (Vector[T], int, error) {
+	n := mustOperator(a, b)
+	if maxIter <= 0 {
+		maxIter = 10 * n
+	}
+	x := make(Vector[T], n)
+	norm := float64(b.Mag())
+	if norm == 0 {
+		return x, 0, nil
+	}
+	target := tol * norm
+
+	r := b.Clone()
+	shadow := b.Clone()
+	p := make(Vector[T], n)
+	v := make(Vector[T], n)
+	var rho, alpha, omega T = 1, 1, 1
+	for iter := 1; iter <= maxIter; iter++ {
+		rhoNext := shadow.Dot(r)
+		if rhoNext == 0 || omega == 0 {
+			return x, iter, ErrNoConvergence
+		}
+		beta := (rhoNext / rho) * (alpha / omega)
+		rho = rhoNext
+		for i := range p {
+			p[i] = r[i] + beta*(p[i]-omega*v[i])
+		}
+		y := precondition(m, p)
+		v = a.MulVec(y)
+		sv := shadow.Dot(v)
+		if sv == 0 {
+			return x, iter, ErrNoConvergence
+		}
+		alpha = rho / sv
+
+		s := r.Clone()
+		axpy(s, -alpha, v)
+		if float64(s.Mag()) <= target {
+			axpy(x, alpha, y)
+			return x, iter, nil
+		}
+		z := precondition(m, s)
+		t := a.MulVec(z)
+		tt := t.Dot(t)
+		if tt == 0 {
+			return x, iter, ErrNoConvergence
+		}
+		omega = t.Dot(s) / tt
+		axpy(x, alpha, y)
+		axpy(x, omega, z)
+		r = s
+		axpy(r, -omega, t)
+		if float64(r.Mag()) <= target {
+			return x, iter, nil
+		}
+	}
+	return x, maxIter, ErrNoConvergence
+}
+
+// mustOperator panics unless a is square and matches b, returning the size of the system.
+func mustOperator[T Numeric](a LinearOperator[T], b Vector[T]) int {
+	rows, cols := a.Dims()
+	if rows != cols {
+		panic(fmt.Sprintf("bm: %dx%d matrix is not square", rows, cols))
+	}
+	b.mustLen(rows)
+	return rows
+}
+
+// precondition applies m to r, or copies r if m is nil.
+func precondition[T Numeric](m Preconditioner[T], r Vector[T]) Vector[T] {
+	if m == nil {
+		return r.Clone()
+	}
+	return m.Apply(r)
+}
+
+// axpy adds alpha * x to y in place.
+func axpy[T Numeric](y Vector[T], alpha T, x Vector[T]) {
+	for i := range y {
+		y[i] += alpha * x[i]
+	}
+}
@@ -0,0 +1,326 @@
+package bm
+
+// singularTolerance is the magnitude, relative to the largest element of a matrix, below which a pivot is treated as zero.
+const singularTolerance = 1e-12
+
+// LU is the LU decomposition with partial pivoting of a square matrix A, so that P*A = L*U with L unit lower
+// triangular and U upper triangular. Decompositions are intended for floating-point types.
+type LU[T Numeric] struct {
+	lu       Dense[T]
+	perm     []int
+	sign     int
+	singular bool
+}
+
+/**
+ * LU returns the LU decomposition with partial pivoting of the square matrix. It panics if the matrix is not square.
+ * The decomposition of a singular matrix succeeds, but solving with it returns ErrSingular.
+ * For example:
+ *   NewDense(2, 2, []float64{1, 2, 3, 4}).LU().Determinant() returns -2
+ */
+func (d Dense[T]) LU() LU[T] {
+	d.mustSquare()
+	f := LU[T]{lu: d.Clone(), perm: make([]int, d.rows)}
+	f.sign, f.singular = luDecompose(f.lu.data, d.rows, f.perm)
+	return f
+}
+
+/**
+ * LU returns the LU decomposition with partial pivoting of the matrix.
+ * For example:
+ *   Mat2[float64]{ {0, 1}, {1, 0} }.LU().Pivot() returns []int{1, 0}
+ */
+func (m Mat2[T]) LU() LU[T] {
+	return DenseFromMat2(m).LU()
+}
+
+/**
+ * LU returns the LU decomposition with partial pivoting of the matrix.
+ * For example:
+ *   IdentityMat3[float64]().LU().Determinant() returns 1
+ */
+func (m Mat3[T]) LU() LU[T] {
+	return DenseFromMat3(m).LU()
+}
+
+/**
+ * LU returns the LU decomposition with partial pivoting of the matrix.
+ * For example:
+ *   IdentityMat4[float64]().LU().Determinant() returns 1
+ */
+func (m Mat4[T]) LU() LU[T] {
+	return DenseFromMat4(m).LU()
+}
+
+/**
+ * L returns the unit lower triangular factor.
+ * For example:
+ *   NewDense(2, 2, []float64{2, 1, 4, 3}).LU().L() returns the matrix [[1, 0], [0.5, 1]]
+ */
+func (f LU[T]) L() Dense[T] {
+	n := len(f.perm)
+	l := IdentityDense[T](n)
+	for i := 1; i < n; i++ {
+		copy(l.data[i*n:i*n+i], f.lu.data[i*n:i*n+i])
+	}
+	return l
+}
+
+/**
+ * U returns the upper triangular factor.
+ * For example:
+ *   NewDense(2, 2, []float64{2, 1, 4, 3}).LU().U() returns the matrix [[4, 3], [0, -0.5]]
+ */
+func (f LU[T]) U() Dense[T] {
+	n := len(f.perm)
+	u := NewDense[T](n, n, nil)
+	for i := 0; i < n; i++ {
+		copy(u.data[i*n+i:(i+1)*n], f.lu.data[i*n+i:(i+1)*n])
+	}
+	return u
+}
+
+/**
+ * P returns the permutation matrix of the row exchanges.
+ * For example:
+ *   NewDense(2, 2, []float64{2, 1, 4, 3}).LU().P() returns the matrix [[0, 1], [1, 0]]
+ */
+func (f LU[T]) P() Dense[T] {
+	n := len(f.perm)
+	p := NewDense[T](n, n, nil)
+	for i, j := range f.perm {
+		p.data[i*n+j] = 1
+	}
+	return p
+}
+
+/**
+ * Pivot returns the row permutation: row i of P*A is row Pivot()[i] of A.
+ * For example:
+ *   NewDense(2, 2, []float64{2, 1, 4, 3}).LU().Pivot() returns []int{1, 0}
+ */
+func (f LU[T]) Pivot() []int {
+	return append([]int(nil), f.perm...)
+}
+
+/**
+ * Singular reports whether a pivot was numerically zero, in which case the matrix has no inverse.
+ * For example:
+ *   NewDense(2, 2, []float64{1, 2, 2, 4}).LU().Singular() returns true
+ */
+func (f LU[T]) Singular() bool {
+	return f.singular
+}
+
+/**
+ * Determinant returns the determinant of the decomposed matrix, or 0 if it is numerically singular.
+ * For example:
+ *   NewDense(2, 2, []float64{2, 1, 4, 3}).LU().Determinant() returns 2
+ */
+func (f LU[T]) Determinant() T {
+	if f.singular {
+		return 0
+	}
+	return luDeterminant(f.lu.data, len(f.perm), f.sign)
+}
+
+/**
+ * Solve returns the solution x of A * x = b, or ErrSingular if A is numerically singular.
+ * It panics if b has the wrong length.
+ * For example:
+ *   NewDense(2, 2, []float64{2, 1, 1, 3}).LU().Solve(Vector[float64]{3, 5}) returns (Vector[float64]{0.8, 1.4}, nil)
+ */
+func (f LU[T]) Solve(b Vector[T]) (Vector[T], error) {
+	b.mustLen(len(f.perm))
+	if f.singular {
+		return nil, ErrSingular
+	}
+	x := make(Vector[T], len(b))
+	luSolve(f.lu.data, len(f.perm), f.perm, b, x)
+	return x, nil
+}
+
+/**
+ * SolveDense returns the solution X of A * X = B for every column of B, or ErrSingular if A is numerically singular.
+ * It panics if B has the wrong number of rows.
+ * For example:
+ *   a.LU().SolveDense(IdentityDense[float64](n)) returns the inverse of a
+ */
+func (f LU[T]) SolveDense(b Dense[T]) (Dense[T], error) {
+	n := len(f.perm)
+	b.mustDims(n, b.cols)
+	if f.singular {
+		return Dense[T]{}, ErrSingular
+	}
+	x := NewDense[T](n, b.cols, nil)
+	col := make([]T, n)
+	sol := make([]T, n)
+	for j := 0; j < b.cols; j++ {
+		for i := 0; i < n; i++ {
+			col[i] = b.data[i*b.cols+j]
+		}
+		luSolve(f.lu.data, n, f.perm, col, sol)
+		for i := 0; i < n; i++ {
+			x.data[i*b.cols+j] = sol[i]
+		}
+	}
+	return x, nil
+}
+
+/**
+ * Inverse returns the inverse of the decomposed matrix, or ErrSingular if it is numerically singular.
+ * For example:
+ *   NewDense(2, 2, []float64{2, 0, 0, 4}).LU().Inverse() returns (the matrix [[0.5, 0], [0, 0.25]], nil)
+ */
+func (f LU[T]) Inverse() (Dense[T], error) {
+	return f.SolveDense(IdentityDense[T](len(f.perm)))
+}
+
+/**
+ * Solve returns the solution x of m * x = b using LU decomposition with partial pivoting, or ErrSingular if m is numerically singular.
+ * For example:
+ *   Mat2[float64]{ {2, 1}, {1, 3} }.Solve(Vec2[float64]{3, 5}) returns (Vec2[float64]{0.8, 1.4}, nil)
+ */
+func (m Mat2[T]) Solve(b Vec2[T]) (Vec2[T], error) {
+	a := [4]T{m[0][0], m[0][1], m[1][0], m[1][1]}
+	var perm [2]int
+	if _, singular := luDecompose(a[:], 2, perm[:]); singular {
+		return Vec2[T]{}, ErrSingular
+	}
+	var x [2]T
+	luSolve(a[:], 2, perm[:], []T{b.X, b.Y}, x[:])
+	return Vec2[T]{X: x[0], Y: x[1]}, nil
+}
+
+/**
+ * Solve returns the solution x of m * x = b using LU decomposition with partial pivoting, or ErrSingular if m is numerically singular.
+ * For example:
+ *   Mat3[float64]{ {2, 0, 0}, {0, 4, 0}, {0, 0, 8} }.Solve(Vec3[float64]{2, 2, 2}) returns (Vec3[float64]{1, 0.5, 0.25}, nil)
+ */
+func (m Mat3[T]) Solve(b Vec3[T]) (Vec3[T], error) {
+	a := flattenMat3(m)
+	var perm [3]int
+	if _, singular := luDecompose(a[:], 3, perm[:]); singular {
+		return Vec3[T]{}, ErrSingular
+	}
+	var x [3]T
+	luSolve(a[:], 3, perm[:], []T{b.X, b.Y, b.Z}, x[:])
+	return Vec3[T]{X: x[0], Y: x[1], Z: x[2]}, nil
+}
+
+/**
+ * Solve returns the solution x of m * x = b using LU decomposition with partial pivoting, or ErrSingular if m is numerically singular.
+ * For example:
+ *   IdentityMat4[float64]().Solve(Vec4[float64]{1, 2, 3, 4}) returns (Vec4[float64]{1, 2, 3, 4}, nil)
+ */
+func (m Mat4[T]) Solve(b Vec4[T]) (Vec4[T], error) {
+	a := flattenMat4(m)
+	var perm [4]int
+	if _, singular := luDecompose(a[:], 4, perm[:]); singular {
+		return Vec4[T]{}, ErrSingular
+	}
+	var x [4]T
+	luSolve(a[:], 4, perm[:], []T{b.X, b.Y, b.Z, b.W}, x[:])
+	return Vec4[T]{X: x[0], Y: x[1], Z: x[2], W: x[3]}, nil
+}
+
+// luDecompose factors the n x n row-major matrix a in place into L (below the diagonal, unit diagonal implied) and U,
+// recording the row permutation in perm. It returns the sign of the permutation and whether a pivot was numerically zero.
+func luDecompose[T Numeric](a []T, n int, perm []int) (int, bool) {
+	var scale float64
+	for _, v := range a {
+		scale = Max(scale, float64(Abs(v)))
+	}
+	tol := singularTolerance * scale
+
+	for i := range perm {
+		perm[i] = i
+	}
+	sign, singular := 1, false
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if Abs(a[i*n+k]) > Abs(a[p*n+k]) {
+				p = i
+			}
+		}
+		if p != k {
+			for j := 0; j < n; j++ {
+				a[p*n+j], a[k*n+j] = a[k*n+j], a[p*n+j]
+			}
+			perm[p], perm[k] = perm[k], perm[p]
+			sign = -sign
+		}
+
+		pivot := a[k*n+k]
+		if float64(Abs(pivot)) <= tol {
+			singular = true
+			continue
+		}
+		for i := k + 1; i < n; i++ {
+			a[i*n+k] /= pivot
+			f := a[i*n+k]
+			if f == 0 {
+				continue
+			}
+			for j := k + 1; j < n; j++ {
+				a[i*n+j] -= f * a[k*n+j]
+			}
+		}
+	}
+	return sign, singular
+}
+
+// luSolve solves (L*U) * x = P*b by forward and back substitution on a factorization from luDecompose.
+func luSolve[T Numeric](lu []T, n int, perm []int, b, x []T) {
+	for i := 0; i < n; i++ {
+		sum := b[perm[i]]
+		for j := 0; j < i; j++ {
+			sum -= lu[i*n+j] * x[j]
+		}
+		x[i] = sum
+	}
+	for i := n - 1; i >= 0; i-- {
+		sum := x[i]
+		for j := i + 1; j < n; j++ {
+			sum -= lu[i*n+j] * x[j]
+		}
+		x[i] = sum / lu[i*n+i]
+	}
+}
+
+// luDeterminant returns the determinant of a factorization from luDecompose.
+func luDeterminant[T Numeric](lu []T, n int, sign int) T {
+	var det T = 1
+	for i := 0; i < n; i++ {
+		det *= lu[i*n+i]
+	}
+	if sign < 0 {
+		return -det
+	}
+	return det
+}
+
+// isIntegral reports whether T is an integer type, whose division truncates.
+func isIntegral[T Numeric]() bool {
+	var one, two T = 1, 2
+	return one/two == 0
+}
+
+// flattenMat3 returns the elements of m in row-major order.
+func flattenMat3[T Numeric](m Mat3[T]) [9]T {
+	var a [9]T
+	for i := range m {
+		copy(a[i*3:], m[i][:])
+	}
+	return a
+}
+
+// flattenMat4 returns the elements of m in row-major order.
+func flattenMat4[T Numeric](m Mat4[T]) [16]T {
+	var a [16]T
+	for i := range m {
+		copy(a[i*4:], m[i][:])
+	}
+	return a
+}
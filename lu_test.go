@@ -0,0 +1,120 @@
+package bm
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestLU tests that the LU factors reproduce the permuted matrix and that solving recovers a known solution.
+func TestLU(t *testing.T) {
+	a := NewDense(4, 4, []float64{
+		0, 2, 1, 4,
+		1, 1, 0, 2,
+		3, 0, 5, 1,
+		2, 7, 1, 0,
+	})
+	f := a.LU()
+	if f.Singular() {
+		t.Fatalf("LU Singular() = true, want false")
+	}
+	if got, expected := f.L().Mul(f.U()), f.P().Mul(a); !denseApproxEqual(got, expected, 1e-12) {
+		t.Errorf("L*U = %v, want P*A = %v", got, expected)
+	}
+	if got, expected := f.Determinant(), a.Mat4().Determinant(); !approxEqual(got, expected, 1e-9) {
+		t.Errorf("LU Determinant() = %v, want %v", got, expected)
+	}
+
+	x := Vector[float64]{1, -2, 3, 0.5}
+	got, err := a.Solve(a.MulVec(x))
+	if err != nil {
+		t.Fatalf("Dense Solve() error = %v", err)
+	}
+	if got.Sub(x).Mag() > 1e-12 {
+		t.Errorf("Dense Solve() = %v, want %v", got, x)
+	}
+
+	inv, err := f.Inverse()
+	if err != nil {
+		t.Fatalf("LU Inverse() error = %v", err)
+	}
+	if !denseApproxEqual(a.Mul(inv), IdentityDense[float64](4), 1e-12) {
+		t.Errorf("A * LU Inverse() = %v, want identity", a.Mul(inv))
+	}
+}
+
+// TestLUSingular tests that solving a singular system reports ErrSingular.
+func TestLUSingular(t *testing.T) {
+	a := NewDense(3, 3, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	if _, err := a.Solve(Vector[float64]{1, 2, 3}); !errors.Is(err, ErrSingular) {
+		t.Errorf("Dense Solve() error = %v, want ErrSingular", err)
+	}
+	if _, err := a.LU().Inverse(); !errors.Is(err, ErrSingular) {
+		t.Errorf("LU Inverse() error = %v, want ErrSingular", err)
+	}
+	if got := a.LU().Determinant(); got != 0 {
+		t.Errorf("LU Determinant() = %v, want 0", got)
+	}
+	if _, err := (Mat3[float64]{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}).Solve(Vec3[float64]{1, 2, 3}); !errors.Is(err, ErrSingular) {
+		t.Errorf("Mat3 Solve() error = %v, want ErrSingular", err)
+	}
+	if _, err := (Mat2[float64]{{1, 2}, {2, 4}}).Solve(Vec2[float64]{1, 2}); !errors.Is(err, ErrSingular) {
+		t.Errorf("Mat2 Solve() error = %v, want ErrSingular", err)
+	}
+}
+
+// TestMatSolve tests the fixed-size solvers and the LU-based inverses against known results.
+func TestMatSolve(t *testing.T) {
+	m2 := Mat2[float64]{{2, 1}, {1, 3}}
+	if got, err := m2.Solve(Vec2[float64]{3, 5}); err != nil || !approxEqual(got.X, 0.8, 1e-12) || !approxEqual(got.Y, 1.4, 1e-12) {
+		t.Errorf("Mat2 Solve() = %v, %v, want {0.8 1.4}", got, err)
+	}
+
+	// The leading zero forces a row exchange.
+	m3 := Mat3[float64]{{0, 2, 1}, {1, 0, 3}, {4, 1, 0}}
+	x3 := Vec3[float64]{1, 2, 3}
+	if got, err := m3.Solve(m3.MulVec(x3)); err != nil || !vec3ApproxEqual(got, x3, 1e-12) {
+		t.Errorf("Mat3 Solve() = %v, %v, want %v", got, err, x3)
+	}
+	inv3, ok := m3.Inverse()
+	if !ok || !mat3ApproxEqual(m3.Mul(inv3), IdentityMat3[float64](), 1e-12) {
+		t.Errorf("Mat3 Inverse() = %v, %v", inv3, ok)
+	}
+
+	m4 := Perspective[float64](1, 1.5, 0.1, 100).Mul(LookAt(Vec3[float64]{1, 2, 3}, Vec3[float64]{}, Vec3[float64]{Y: 1}))
+	x4 := Vec4[float64]{1, -1, 2, 1}
+	got4, err := m4.Solve(m4.MulVec(x4))
+	if err != nil || !approxEqual(got4.Sub(x4).Mag(), 0, 1e-9) {
+		t.Errorf("Mat4 Solve() = %v, %v, want %v", got4, err, x4)
+	}
+	inv4, ok := m4.Inverse()
+	if !ok || !mat4ApproxEqual(m4.Mul(inv4), IdentityMat4[float64](), 1e-9) {
+		t.Errorf("Mat4 Inverse() = %v, %v", inv4, ok)
+	}
+	if _, ok := (Mat4[float64]{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10, 11, 12}, {13, 14, 15, 16}}).Inverse(); ok {
+		t.Errorf("Mat4 Inverse() of a singular matrix reported success")
+	}
+}
+
+// TestIntegerInverse tests that Inverse stays exact for integer matrices and fails when the inverse is not integral.
+func TestIntegerInverse(t *testing.T) {
+	m3 := Mat3[int]{{1, 2, 3}, {0, 1, 4}, {5, 6, 0}}
+	want3 := Mat3[int]{{-24, 18, 5}, {20, -15, -4}, {-5, 4, 1}}
+	if got, ok := m3.Inverse(); !ok || got != want3 {
+		t.Errorf("Mat3[int] Inverse() = %v, %v, want %v, true", got, ok, want3)
+	}
+	if _, ok := (Mat3[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}).Inverse(); ok {
+		t.Errorf("Mat3[int] Inverse() of a singular matrix reported success")
+	}
+	if _, ok := (Mat3[int]{{2, 0, 0}, {0, 1, 0}, {0, 0, 1}}).Inverse(); ok {
+		t.Errorf("Mat3[int] Inverse() with a non-integer inverse reported success")
+	}
+
+	m4 := Mat4[int]{{1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}, {1, 2, 0, 1}}
+	want4 := Mat4[int]{{1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}, {-1, -2, 0, 1}}
+	if got, ok := m4.Inverse(); !ok || got != want4 {
+		t.Errorf("Mat4[int] Inverse() = %v, %v, want %v, true", got, ok, want4)
+	}
+	if _, ok := (Mat4[int]{{1, 0, 0, 0}, {0, 3, 0, 0}, {0, 0, 1, 0}, {0, 0, 0, 1}}).Inverse(); ok {
+		t.Errorf("Mat4[int] Inverse() with a non-integer inverse reported success")
+	}
+}
@@ -126,6 +126,19 @@ func (m Mat2[T]) Inverse() (Mat2[T], bool) {
 	}, true
 }
 
+/**
+ * InverseE returns the inverse of the matrix like Inverse, or ErrSingular if the matrix is singular.
+ * For example:
+ *   Mat2[float64]{ {1, 2}, {2, 4} }.InverseE() returns (Mat2[float64]{}, ErrSingular)
+ */
+func (m Mat2[T]) InverseE() (Mat2[T], error) {
+	inv, ok := m.Inverse()
+	if !ok {
+		return Mat2[T]{}, ErrSingular
+	}
+	return inv, nil
+}
+
 /**
  * String returns a string representation of the matrix in a human-readable format.
  * For example:
@@ -162,3 +175,27 @@ func (m Mat2[T]) Rotate(angle T) Mat2[T] {
 		{sinA, cosA},
 	}
 }
+
+/**
+ * MulVec multiplies the matrix by the column vector v and returns the result.
+ * For example:
+ *   Mat2[int]{ {1, 2}, {3, 4} }.MulVec(Vec2[int]{5, 6}) returns Vec2[int]{17, 39}
+ */
+func (m Mat2[T]) MulVec(v Vec2[T]) Vec2[T] {
+	return Vec2[T]{
+		X: m[0][0]*v.X + m[0][1]*v.Y,
+		Y: m[1][0]*v.X + m[1][1]*v.Y,
+	}
+}
+
+/**
+ * VecMul multiplies the row vector v by the matrix and returns the result.
+ * For example:
+ *   Mat2[int]{ {1, 2}, {3, 4} }.VecMul(Vec2[int]{5, 6}) returns Vec2[int]{23, 34}
+ */
+func (m Mat2[T]) VecMul(v Vec2[T]) Vec2[T] {
+	return Vec2[T]{
+		X: v.X*m[0][0] + v.Y*m[1][0],
+		Y: v.X*m[0][1] + v.Y*m[1][1],
+	}
+}
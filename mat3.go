@@ -114,36 +114,82 @@ func (m Mat3[T]) Determinant() T {
 }
 
 /**
- * Inverse returns the inverse of the matrix and a boolean indicating success. If the matrix is singular (determinant is zero), it returns false indicating that the inverse does not exist.
+ * Inverse returns the inverse of the matrix and a boolean indicating success. Float matrices are inverted by LU
+ * decomposition with partial pivoting and fail when numerically singular. Integer matrices are inverted exactly from the
+ * adjugate and fail when the determinant is zero or the inverse has non-integer entries.
  * For example:
  *   Mat3[int]{ {1, 2, 3}, {0, 1, 4}, {5, 6, 0} }.Inverse()
  *   returns (Mat3[int]{ { -24, 18, 5 }, { 20, -15, -4 }, { -5, 4, 1 } }, true)
- *   Mat3[int]{ {1, 2, 3}, {4, 5, 6}, {7, 8, 9} }.Inverse()
- *   returns (Mat3[int]{}, false) since the matrix is singular.
+ *   Mat3[float64]{ {1, 2, 3}, {4, 5, 6}, {7, 8, 9} }.Inverse()
+ *   returns (Mat3[float64]{}, false) since the matrix is singular.
  */
 func (m Mat3[T]) Inverse() (Mat3[T], bool) {
+	if isIntegral[T]() {
+		return m.exactInverse()
+	}
+	a := flattenMat3(m)
+	var perm [3]int
+	if _, singular := luDecompose(a[:], 3, perm[:]); singular {
+		return Mat3[T]{}, false
+	}
+	var inv Mat3[T]
+	for j := 0; j < 3; j++ {
+		var e, x [3]T
+		e[j] = 1
+		luSolve(a[:], 3, perm[:], e[:], x[:])
+		for i := range x {
+			inv[i][j] = x[i]
+		}
+	}
+	return inv, true
+}
+
+// exactInverse divides the adjugate by the determinant, failing unless every entry divides exactly.
+func (m Mat3[T]) exactInverse() (Mat3[T], bool) {
 	det := m.Determinant()
 	if det == 0 {
 		return Mat3[T]{}, false
 	}
-	invDet := 1 / det
-	return Mat3[T]{
+	inv := Mat3[T]{
 		{
-			(m[1][1]*m[2][2] - m[1][2]*m[2][1]) * invDet,
-			(m[0][2]*m[2][1] - m[0][1]*m[2][2]) * invDet,
-			(m[0][1]*m[1][2] - m[0][2]*m[1][1]) * invDet,
+			m[1][1]*m[2][2] - m[1][2]*m[2][1],
+			m[0][2]*m[2][1] - m[0][1]*m[2][2],
+			m[0][1]*m[1][2] - m[0][2]*m[1][1],
 		},
 		{
-			(m[1][2]*m[2][0] - m[1][0]*m[2][2]) * invDet,
-			(m[0][0]*m[2][2] - m[0][2]*m[2][0]) * invDet,
-			(m[0][2]*m[1][0] - m[0][0]*m[1][2]) * invDet,
+			m[1][2]*m[2][0] - m[1][0]*m[2][2],
+			m[0][0]*m[2][2] - m[0][2]*m[2][0],
+			m[0][2]*m[1][0] - m[0][0]*m[1][2],
 		},
 		{
-			(m[1][0]*m[2][1] - m[1][1]*m[2][0]) * invDet,
-			(m[0][1]*m[2][0] - m[0][0]*m[2][1]) * invDet,
-			(m[0][0]*m[1][1] - m[0][1]*m[1][0]) * invDet,
+			m[1][0]*m[2][1] - m[1][1]*m[2][0],
+			m[0][1]*m[2][0] - m[0][0]*m[2][1],
+			m[0][0]*m[1][1] - m[0][1]*m[1][0],
 		},
-	}, true
+	}
+	for i := range inv {
+		for j := range inv[i] {
+			q := inv[i][j] / det
+			if q*det != inv[i][j] {
+				return Mat3[T]{}, false
+			}
+			inv[i][j] = q
+		}
+	}
+	return inv, true
+}
+
+/**
+ * InverseE returns the inverse of the matrix like Inverse, or ErrSingular if the matrix is singular.
+ * For example:
+ *   Mat3[float64]{ {1, 2, 3}, {4, 5, 6}, {7, 8, 9} }.InverseE() returns (Mat3[float64]{}, ErrSingular)
+ */
+func (m Mat3[T]) InverseE() (Mat3[T], error) {
+	inv, ok := m.Inverse()
+	if !ok {
+		return Mat3[T]{}, ErrSingular
+	}
+	return inv, nil
 }
 
 /**
@@ -218,49 +264,224 @@ func RotateZ[T Numeric](angle T) Mat3[T] {
 }
 
 /**
- * RotateX returns a rotation matrix around the X-axis for a given angle in radians.
+ * RotateX returns the matrix multiplied on the right by a rotation around the X-axis by angle radians, so the rotation is applied before m.
  * For example:
- *   Mat3[float64]{ {1, 0, 0}, {0, 0.7071, -0.7071}, {0, 0.7071, 0.7071} }.RotateX(math.Pi / 4)
+ *   IdentityMat3[float64]().RotateX(math.Pi / 4)
  *   returns Mat3[float64]{ {1, 0, 0}, {0, 0.7071, -0.7071}, {0, 0.7071, 0.7071} }
  */
 func (m Mat3[T]) RotateX(angle T) Mat3[T] {
-	cosA := Cos(angle)
-	sinA := Sin(angle)
-	return Mat3[T]{
-		{1, 0, 0},
-		{0, cosA, -sinA},
-		{0, sinA, cosA},
-	}
+	return m.Mul(RotateX(angle))
 }
 
 /**
- * RotateY returns a rotation matrix around the Y-axis for a given angle in radians.
+ * RotateY returns the matrix multiplied on the right by a rotation around the Y-axis by angle radians, so the rotation is applied before m.
  * For example:
- *   Mat3[float64]{ {0.7071, 0, 0.7071}, {0, 1, 0}, {-0.7071, 0, 0.7071} }.RotateY(math.Pi / 4)
+ *   IdentityMat3[float64]().RotateY(math.Pi / 4)
  *   returns Mat3[float64]{ {0.7071, 0, 0.7071}, {0, 1, 0}, {-0.7071, 0, 0.7071} }
  */
 func (m Mat3[T]) RotateY(angle T) Mat3[T] {
-	cosA := Cos(angle)
-	sinA := Sin(angle)
-	return Mat3[T]{
-		{cosA, 0, sinA},
-		{0, 1, 0},
-		{-sinA, 0, cosA},
-	}
+	return m.Mul(RotateY(angle))
 }
 
 /**
- * RotateZ returns a rotation matrix around the Z-axis for a given angle in radians.
+ * RotateZ returns the matrix multiplied on the right by a rotation around the Z-axis by angle radians, so the rotation is applied before m.
  * For example:
- *   Mat3[float64]{ {0.7071, -0.7071, 0}, {0.7071, 0.7071, 0}, {0, 0, 1} }.RotateZ(math.Pi / 4)
+ *   IdentityMat3[float64]().RotateZ(math.Pi / 4)
  *   returns Mat3[float64]{ {0.7071, -0.7071, 0}, {0.7071, 0.7071, 0}, {0, 0, 1} }
  */
 func (m Mat3[T]) RotateZ(angle T) Mat3[T] {
-	cosA := Cos(angle)
-	sinA := Sin(angle)
+	return m.Mul(RotateZ(angle))
+}
+
+/**
+ * MulVec multiplies the matrix by the column vector v and returns the result.
+ * For example:
+ *   Mat3[int]{ {1, 2, 3}, {4, 5, 6}, {7, 8, 9} }.MulVec(Vec3[int]{1, 0, 2}) returns Vec3[int]{7, 16, 25}
+ */
+func (m Mat3[T]) MulVec(v Vec3[T]) Vec3[T] {
+	return Vec3[T]{
+		X: m[0][0]*v.X + m[0][1]*v.Y + m[0][2]*v.Z,
+		Y: m[1][0]*v.X + m[1][1]*v.Y + m[1][2]*v.Z,
+		Z: m[2][0]*v.X + m[2][1]*v.Y + m[2][2]*v.Z,
+	}
+}
+
+/**
+ * VecMul multiplies the row vector v by the matrix and returns the result.
+ * For example:
+ *   Mat3[int]{ {1, 2, 3}, {4, 5, 6}, {7, 8, 9} }.VecMul(Vec3[int]{1, 0, 2}) returns Vec3[int]{15, 18, 21}
+ */
+func (m Mat3[T]) VecMul(v Vec3[T]) Vec3[T] {
+	return Vec3[T]{
+		X: v.X*m[0][0] + v.Y*m[1][0] + v.Z*m[2][0],
+		Y: v.X*m[0][1] + v.Y*m[1][1] + v.Z*m[2][1],
+		Z: v.X*m[0][2] + v.Y*m[1][2] + v.Z*m[2][2],
+	}
+}
+
+/**
+ * ScaleMat3 returns a 3x3 matrix that scales along the X, Y and Z axes by the components of v.
+ * For example:
+ *   ScaleMat3(Vec3[int]{2, 3, 4}) returns Mat3[int]{ {2, 0, 0}, {0, 3, 0}, {0, 0, 4} }
+ */
+func ScaleMat3[T Numeric](v Vec3[T]) Mat3[T] {
+	return Mat3[T]{
+		{v.X, 0, 0},
+		{0, v.Y, 0},
+		{0, 0, v.Z},
+	}
+}
+
+/**
+ * ShearMat3 returns a 3x3 shear matrix. Each factor names the axis being sheared followed by the axis it is sheared by,
+ * so xy adds xy*y to x.
+ * For example:
+ *   ShearMat3[int](1, 0, 0, 0, 0, 0) returns Mat3[int]{ {1, 1, 0}, {0, 1, 0}, {0, 0, 1} }
+ */
+func ShearMat3[T Numeric](xy, xz, yx, yz, zx, zy T) Mat3[T] {
+	return Mat3[T]{
+		{1, xy, xz},
+		{yx, 1, yz},
+		{zx, zy, 1},
+	}
+}
+
+/**
+ * RotateMat3 returns a 3x3 rotation matrix for a rotation around axis by angle radians. The axis does not need to be normalized.
+ * For example:
+ *   RotateMat3(Vec3[float64]{0, 0, 1}, math.Pi/2) returns approximately RotateZ(math.Pi/2)
+ */
+func RotateMat3[T Numeric](axis Vec3[T], angle T) Mat3[T] {
+	return QuatFromAxisAngle(axis, angle).Mat3()
+}
+
+/**
+ * Rotated returns the matrix multiplied on the right by a rotation around axis by angle radians, so the rotation is applied before m.
+ * For example:
+ *   IdentityMat3[float64]().Rotated(Vec3[float64]{1, 0, 0}, math.Pi/2) returns approximately RotateX(math.Pi/2)
+ */
+func (m Mat3[T]) Rotated(axis Vec3[T], angle T) Mat3[T] {
+	return m.Mul(RotateMat3(axis, angle))
+}
+
+/**
+ * Scaled returns the matrix multiplied on the right by a scale by v, so the scale is applied before m.
+ * For example:
+ *   IdentityMat3[int]().Scaled(Vec3[int]{2, 3, 4}) returns Mat3[int]{ {2, 0, 0}, {0, 3, 0}, {0, 0, 4} }
+ */
+func (m Mat3[T]) Scaled(v Vec3[T]) Mat3[T] {
+	return m.Mul(ScaleMat3(v))
+}
+
+/**
+ * Sheared returns the matrix multiplied on the right by ShearMat3(xy, xz, yx, yz, zx, zy), so the shear is applied before m.
+ * For example:
+ *   IdentityMat3[int]().Sheared(1, 0, 0, 0, 0, 0) returns ShearMat3[int](1, 0, 0, 0, 0, 0)
+ */
+func (m Mat3[T]) Sheared(xy, xz, yx, yz, zx, zy T) Mat3[T] {
+	return m.Mul(ShearMat3(xy, xz, yx, yz, zx, zy))
+}
+
+/**
+ * Translate2D returns a 3x3 homogeneous matrix that moves 2D points by v.
+ * For example:
+ *   Translate2D(Vec2[int]{1, 2}) returns Mat3[int]{ {1, 0, 1}, {0, 1, 2}, {0, 0, 1} }
+ */
+func Translate2D[T Numeric](v Vec2[T]) Mat3[T] {
+	return Mat3[T]{
+		{1, 0, v.X},
+		{0, 1, v.Y},
+		{0, 0, 1},
+	}
+}
+
+/**
+ * Rotate2D returns a 3x3 homogeneous matrix that rotates 2D points around the origin by angle radians.
+ * For example:
+ *   Rotate2D(math.Pi / 2) returns Mat3[float64]{ {0, -1, 0}, {1, 0, 0}, {0, 0, 1} }
+ */
+func Rotate2D[T Numeric](angle T) Mat3[T] {
+	var cosA, sinA T = Cos(angle), Sin(angle)
 	return Mat3[T]{
 		{cosA, -sinA, 0},
 		{sinA, cosA, 0},
 		{0, 0, 1},
 	}
 }
+
+/**
+ * Scale2D returns a 3x3 homogeneous matrix that scales 2D points along the X and Y axes by the components of v.
+ * For example:
+ *   Scale2D(Vec2[int]{2, 3}) returns Mat3[int]{ {2, 0, 0}, {0, 3, 0}, {0, 0, 1} }
+ */
+func Scale2D[T Numeric](v Vec2[T]) Mat3[T] {
+	return Mat3[T]{
+		{v.X, 0, 0},
+		{0, v.Y, 0},
+		{0, 0, 1},
+	}
+}
+
+/**
+ * Shear2D returns a 3x3 homogeneous matrix that adds x*y to X and y*x to Y.
+ * For example:
+ *   Shear2D[int](1, 0) returns Mat3[int]{ {1, 1, 0}, {0, 1, 0}, {0, 0, 1} }
+ */
+func Shear2D[T Numeric](x, y T) Mat3[T] {
+	return Mat3[T]{
+		{1, x, 0},
+		{y, 1, 0},
+		{0, 0, 1},
+	}
+}
+
+/**
+ * Translated2D returns the matrix multiplied on the right by Translate2D(v), so the translation is applied before m.
+ * For example:
+ *   Scale2D(Vec2[int]{2, 2}).Translated2D(Vec2[int]{1, 0}) returns Mat3[int]{ {2, 0, 2}, {0, 2, 0}, {0, 0, 1} }
+ */
+func (m Mat3[T]) Translated2D(v Vec2[T]) Mat3[T] {
+	return m.Mul(Translate2D(v))
+}
+
+/**
+ * Rotated2D returns the matrix multiplied on the right by Rotate2D(angle), so the rotation is applied before m.
+ * For example:
+ *   Translate2D(Vec2[float64]{1, 0}).Rotated2D(math.Pi / 2) rotates points and then moves them by 1 along X
+ */
+func (m Mat3[T]) Rotated2D(angle T) Mat3[T] {
+	return m.Mul(Rotate2D(angle))
+}
+
+/**
+ * Scaled2D returns the matrix multiplied on the right by Scale2D(v), so the scale is applied before m.
+ * For example:
+ *   Translate2D(Vec2[int]{1, 2}).Scaled2D(Vec2[int]{2, 2}) returns Mat3[int]{ {2, 0, 1}, {0, 2, 2}, {0, 0, 1} }
+ */
+func (m Mat3[T]) Scaled2D(v Vec2[T]) Mat3[T] {
+	return m.Mul(Scale2D(v))
+}
+
+/**
+ * TransformPoint2D transforms the 2D point p as the homogeneous vector (p, 1).
+ * For example:
+ *   Translate2D(Vec2[int]{1, 2}).TransformPoint2D(Vec2[int]{3, 4}) returns Vec2[int]{4, 6}
+ */
+func (m Mat3[T]) TransformPoint2D(p Vec2[T]) Vec2[T] {
+	return Vec2[T]{
+		X: m[0][0]*p.X + m[0][1]*p.Y + m[0][2],
+		Y: m[1][0]*p.X + m[1][1]*p.Y + m[1][2],
+	}
+}
+
+/**
+ * TransformDirection2D transforms the 2D direction d as the homogeneous vector (d, 0), ignoring any translation.
+ * For example:
+ *   Translate2D(Vec2[int]{1, 2}).TransformDirection2D(Vec2[int]{3, 4}) returns Vec2[int]{3, 4}
+ */
+func (m Mat3[T]) TransformDirection2D(d Vec2[T]) Vec2[T] {
+	return Vec2[T]{
+		X: m[0][0]*d.X + m[0][1]*d.Y,
+		Y: m[1][0]*d.X + m[1][1]*d.Y,
+	}
+}
@@ -156,7 +156,10 @@ func (m Mat4[T]) Minor(row, col int) T {
 }
 
 /**
- * Inverse returns the inverse of the matrix and a boolean indicating success.
+ * Inverse returns the inverse of the matrix and a boolean indicating success. Float matrices are inverted by LU
+ * decomposition with partial pivoting and fail when numerically singular. Integer matrices are inverted exactly from the
+ * cofactors and fail when the determinant is zero or the inverse has non-integer entries. A nearly singular float
+ * matrix can still be inverted with a large error, so check Cond() or use InverseSVD when that matters.
  * For example:
  *   Mat4[float64]{ {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}, {0, 0, 0, 1} }.Inverse()
  *   returns (Mat4[float64]{ {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}, {0, 0, 0, 1} }, true)
@@ -164,18 +167,57 @@ func (m Mat4[T]) Minor(row, col int) T {
  *   returns (Mat4[float64]{}, false) (as this matrix is singular)
  */
 func (m Mat4[T]) Inverse() (Mat4[T], bool) {
+	if isIntegral[T]() {
+		return m.exactInverse()
+	}
+	a := flattenMat4(m)
+	var perm [4]int
+	if _, singular := luDecompose(a[:], 4, perm[:]); singular {
+		return Mat4[T]{}, false
+	}
+	var inv Mat4[T]
+	for j := 0; j < 4; j++ {
+		var e, x [4]T
+		e[j] = 1
+		luSolve(a[:], 4, perm[:], e[:], x[:])
+		for i := range x {
+			inv[i][j] = x[i]
+		}
+	}
+	return inv, true
+}
+
+// exactInverse divides the adjoint by the determinant, failing unless every entry divides exactly.
+func (m Mat4[T]) exactInverse() (Mat4[T], bool) {
 	det := m.Determinant()
 	if det == 0 {
 		return Mat4[T]{}, false
 	}
-	var adjoint Mat4[T]
+	var inv Mat4[T]
 	for i := 0; i < 4; i++ {
 		for j := 0; j < 4; j++ {
-			adjoint[j][i] = m.Cofactor(i, j)
+			c := m.Cofactor(i, j)
+			q := c / det
+			if q*det != c {
+				return Mat4[T]{}, false
+			}
+			inv[j][i] = q
 		}
 	}
-	invDet := 1 / det
-	return adjoint.ScalarMul(invDet), true
+	return inv, true
+}
+
+/**
+ * InverseE returns the inverse of the matrix like Inverse, or ErrSingular if the matrix is singular.
+ * For example:
+ *   IdentityMat4[float64]().InverseE() returns (IdentityMat4[float64](), nil)
+ */
+func (m Mat4[T]) InverseE() (Mat4[T], error) {
+	inv, ok := m.Inverse()
+	if !ok {
+		return Mat4[T]{}, ErrSingular
+	}
+	return inv, nil
 }
 
 /**
@@ -209,52 +251,317 @@ func (m Mat4[T]) Norm() T {
 }
 
 /**
- * RotateX returns a rotation matrix around the X-axis for a given angle in radians.
+ * RotateX returns the matrix multiplied on the right by a rotation around the X-axis by angle radians, so the rotation is applied before m.
  * For example:
- *   Mat4[float64]{}.RotateX(math.Pi / 2)
+ *   IdentityMat4[float64]().RotateX(math.Pi / 2)
  *   returns Mat4[float64]{ {1, 0, 0, 0}, {0, 0, -1, 0}, {0, 1, 0, 0}, {0, 0, 0, 1} }
  */
 func (m Mat4[T]) RotateX(angle T) Mat4[T] {
 	cosA := Cos(angle)
 	sinA := Sin(angle)
-	return Mat4[T]{
+	return m.Mul(Mat4[T]{
 		{1, 0, 0, 0},
 		{0, cosA, -sinA, 0},
 		{0, sinA, cosA, 0},
 		{0, 0, 0, 1},
-	}
+	})
 }
 
 /**
- * RotateY returns a rotation matrix around the Y-axis for a given angle in radians.
+ * RotateY returns the matrix multiplied on the right by a rotation around the Y-axis by angle radians, so the rotation is applied before m.
  * For example:
- *   Mat4[float64]{}.RotateY(math.Pi / 2)
+ *   IdentityMat4[float64]().RotateY(math.Pi / 2)
  *   returns Mat4[float64]{ {0, 0, 1, 0}, {0, 1, 0, 0}, {-1, 0, 0, 0}, {0, 0, 0, 1} }
  */
 func (m Mat4[T]) RotateY(angle T) Mat4[T] {
 	cosA := Cos(angle)
 	sinA := Sin(angle)
-	return Mat4[T]{
+	return m.Mul(Mat4[T]{
 		{cosA, 0, sinA, 0},
 		{0, 1, 0, 0},
 		{-sinA, 0, cosA, 0},
 		{0, 0, 0, 1},
-	}
+	})
 }
 
 /**
- * RotateZ returns a rotation matrix around the Z-axis for a given angle in radians.
+ * RotateZ returns the matrix multiplied on the right by a rotation around the Z-axis by angle radians, so the rotation is applied before m.
  * For example:
- *   Mat4[float64]{}.RotateZ(math.Pi / 2)
+ *   IdentityMat4[float64]().RotateZ(math.Pi / 2)
  *   returns Mat4[float64]{ {0, -1, 0, 0}, {1, 0, 0, 0}, {0, 0, 1, 0}, {0, 0, 0, 1} }
  */
 func (m Mat4[T]) RotateZ(angle T) Mat4[T] {
 	cosA := Cos(angle)
 	sinA := Sin(angle)
-	return Mat4[T]{
+	return m.Mul(Mat4[T]{
 		{cosA, -sinA, 0, 0},
 		{sinA, cosA, 0, 0},
 		{0, 0, 1, 0},
 		{0, 0, 0, 1},
+	})
+}
+
+/**
+ * Perspective returns a right-handed perspective projection matrix that maps view-space depth to the OpenGL clip range [-1, 1].
+ * fovY is the vertical field of view in radians and aspect is width divided by height.
+ * For example:
+ *   Perspective[float64](math.Pi/2, 1, 1, 3)
+ *   returns Mat4[float64]{ {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, -2, -3}, {0, 0, -1, 0} }
+ */
+func Perspective[T Numeric](fovY, aspect, near, far T) Mat4[T] {
+	var one T = 1
+	f := 1 / Tan(fovY/2)
+	return Mat4[T]{
+		{f / aspect, 0, 0, 0},
+		{0, f, 0, 0},
+		{0, 0, (far + near) / (near - far), 2 * far * near / (near - far)},
+		{0, 0, -one, 0},
+	}
+}
+
+/**
+ * PerspectiveInfinite returns a right-handed perspective projection matrix with the far plane at infinity,
+ * mapping view-space depth to the OpenGL clip range [-1, 1].
+ * For example:
+ *   PerspectiveInfinite[float64](math.Pi/2, 1, 1)
+ *   returns Mat4[float64]{ {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, -1, -2}, {0, 0, -1, 0} }
+ */
+func PerspectiveInfinite[T Numeric](fovY, aspect, near T) Mat4[T] {
+	var one T = 1
+	f := 1 / Tan(fovY/2)
+	return Mat4[T]{
+		{f / aspect, 0, 0, 0},
+		{0, f, 0, 0},
+		{0, 0, -one, -(2 * near)},
+		{0, 0, -one, 0},
+	}
+}
+
+/**
+ * PerspectiveReversedZ returns a right-handed perspective projection matrix with reversed depth,
+ * mapping the near plane to 1 and the far plane to 0. Reversed depth gives far better precision with floating-point depth buffers.
+ * For example:
+ *   PerspectiveReversedZ[float64](math.Pi/2, 1, 1, 3)
+ *   returns Mat4[float64]{ {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 0.5, 1.5}, {0, 0, -1, 0} }
+ */
+func PerspectiveReversedZ[T Numeric](fovY, aspect, near, far T) Mat4[T] {
+	var one T = 1
+	f := 1 / Tan(fovY/2)
+	return Mat4[T]{
+		{f / aspect, 0, 0, 0},
+		{0, f, 0, 0},
+		{0, 0, near / (far - near), far * near / (far - near)},
+		{0, 0, -one, 0},
+	}
+}
+
+/**
+ * PerspectiveInfiniteReversedZ returns a right-handed perspective projection matrix with reversed depth and the far plane at infinity,
+ * mapping the near plane to 1 and infinity to 0.
+ * For example:
+ *   PerspectiveInfiniteReversedZ[float64](math.Pi/2, 1, 1)
+ *   returns Mat4[float64]{ {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 0, 1}, {0, 0, -1, 0} }
+ */
+func PerspectiveInfiniteReversedZ[T Numeric](fovY, aspect, near T) Mat4[T] {
+	var one T = 1
+	f := 1 / Tan(fovY/2)
+	return Mat4[T]{
+		{f / aspect, 0, 0, 0},
+		{0, f, 0, 0},
+		{0, 0, 0, near},
+		{0, 0, -one, 0},
+	}
+}
+
+/**
+ * FrustumMat4 returns a right-handed off-center perspective projection matrix for the given near-plane rectangle,
+ * mapping view-space depth to the OpenGL clip range [-1, 1]. It matches the classic glFrustum.
+ * For example:
+ *   FrustumMat4[float64](-1, 1, -1, 1, 1, 3)
+ *   returns Mat4[float64]{ {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, -2, -3}, {0, 0, -1, 0} }
+ */
+func FrustumMat4[T Numeric](left, right, bottom, top, near, far T) Mat4[T] {
+	var one T = 1
+	return Mat4[T]{
+		{2 * near / (right - left), 0, (right + left) / (right - left), 0},
+		{0, 2 * near / (top - bottom), (top + bottom) / (top - bottom), 0},
+		{0, 0, (far + near) / (near - far), 2 * far * near / (near - far)},
+		{0, 0, -one, 0},
+	}
+}
+
+/**
+ * Orthographic returns a right-handed orthographic projection matrix mapping the given box to the OpenGL clip cube [-1, 1].
+ * For example:
+ *   Orthographic[float64](-1, 1, -1, 1, -1, 1)
+ *   returns Mat4[float64]{ {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, -1, 0}, {0, 0, 0, 1} }
+ */
+func Orthographic[T Numeric](left, right, bottom, top, near, far T) Mat4[T] {
+	return Mat4[T]{
+		{2 / (right - left), 0, 0, (right + left) / (left - right)},
+		{0, 2 / (top - bottom), 0, (top + bottom) / (bottom - top)},
+		{0, 0, 2 / (near - far), (far + near) / (near - far)},
+		{0, 0, 0, 1},
+	}
+}
+
+/**
+ * LookAt returns a right-handed view matrix for a camera at eye looking towards center, with up giving the approximate up direction.
+ * For example:
+ *   LookAt(NewVec3[float64](0, 0, 5), NewVec3[float64](0, 0, 0), NewVec3[float64](0, 1, 0))
+ *   returns Mat4[float64]{ {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, -5}, {0, 0, 0, 1} }
+ */
+func LookAt[T Numeric](eye, center, up Vec3[T]) Mat4[T] {
+	f := center.Sub(eye).Norm()
+	s := f.Cross(up).Norm()
+	u := s.Cross(f)
+	return Mat4[T]{
+		{s.X, s.Y, s.Z, -s.Dot(eye)},
+		{u.X, u.Y, u.Z, -u.Dot(eye)},
+		{-f.X, -f.Y, -f.Z, f.Dot(eye)},
+		{0, 0, 0, 1},
+	}
+}
+
+/**
+ * MulVec multiplies the matrix by the column vector v and returns the result.
+ * For example:
+ *   IdentityMat4[int]().MulVec(Vec4[int]{1, 2, 3, 4}) returns Vec4[int]{1, 2, 3, 4}
+ */
+func (m Mat4[T]) MulVec(v Vec4[T]) Vec4[T] {
+	return Vec4[T]{
+		X: m[0][0]*v.X + m[0][1]*v.Y + m[0][2]*v.Z + m[0][3]*v.W,
+		Y: m[1][0]*v.X + m[1][1]*v.Y + m[1][2]*v.Z + m[1][3]*v.W,
+		Z: m[2][0]*v.X + m[2][1]*v.Y + m[2][2]*v.Z + m[2][3]*v.W,
+		W: m[3][0]*v.X + m[3][1]*v.Y + m[3][2]*v.Z + m[3][3]*v.W,
+	}
+}
+
+/**
+ * VecMul multiplies the row vector v by the matrix and returns the result.
+ * For example:
+ *   IdentityMat4[int]().VecMul(Vec4[int]{1, 2, 3, 4}) returns Vec4[int]{1, 2, 3, 4}
+ */
+func (m Mat4[T]) VecMul(v Vec4[T]) Vec4[T] {
+	return Vec4[T]{
+		X: v.X*m[0][0] + v.Y*m[1][0] + v.Z*m[2][0] + v.W*m[3][0],
+		Y: v.X*m[0][1] + v.Y*m[1][1] + v.Z*m[2][1] + v.W*m[3][1],
+		Z: v.X*m[0][2] + v.Y*m[1][2] + v.Z*m[2][2] + v.W*m[3][2],
+		W: v.X*m[0][3] + v.Y*m[1][3] + v.Z*m[2][3] + v.W*m[3][3],
+	}
+}
+
+/**
+ * TransformPoint transforms the point p as the homogeneous vector (p, 1) and performs the perspective divide.
+ * If the resulting w is zero, the divide is skipped.
+ * For example:
+ *   Perspective[float64](math.Pi/2, 1, 1, 3).TransformPoint(Vec3[float64]{0, 0, -1}) returns Vec3[float64]{0, 0, -1}
+ */
+func (m Mat4[T]) TransformPoint(p Vec3[T]) Vec3[T] {
+	r := m.MulVec(Vec4[T]{X: p.X, Y: p.Y, Z: p.Z, W: 1})
+	if r.W == 0 || r.W == 1 {
+		return Vec3[T]{X: r.X, Y: r.Y, Z: r.Z}
+	}
+	return Vec3[T]{X: r.X / r.W, Y: r.Y / r.W, Z: r.Z / r.W}
+}
+
+/**
+ * TransformDirection transforms the direction d as the homogeneous vector (d, 0), ignoring any translation.
+ * For example:
+ *   IdentityMat4[float64]().RotateZ(math.Pi/2).TransformDirection(Vec3[float64]{1, 0, 0}) returns approximately Vec3[float64]{0, 1, 0}
+ */
+func (m Mat4[T]) TransformDirection(d Vec3[T]) Vec3[T] {
+	return Vec3[T]{
+		X: m[0][0]*d.X + m[0][1]*d.Y + m[0][2]*d.Z,
+		Y: m[1][0]*d.X + m[1][1]*d.Y + m[1][2]*d.Z,
+		Z: m[2][0]*d.X + m[2][1]*d.Y + m[2][2]*d.Z,
+	}
+}
+
+/**
+ * TranslateMat4 returns a 4x4 translation matrix that moves points by v.
+ * For example:
+ *   TranslateMat4(Vec3[int]{1, 2, 3}) returns Mat4[int]{ {1, 0, 0, 1}, {0, 1, 0, 2}, {0, 0, 1, 3}, {0, 0, 0, 1} }
+ */
+func TranslateMat4[T Numeric](v Vec3[T]) Mat4[T] {
+	return Mat4[T]{
+		{1, 0, 0, v.X},
+		{0, 1, 0, v.Y},
+		{0, 0, 1, v.Z},
+		{0, 0, 0, 1},
 	}
 }
+
+/**
+ * ScaleMat4 returns a 4x4 matrix that scales along the X, Y and Z axes by the components of v.
+ * For example:
+ *   ScaleMat4(Vec3[int]{2, 3, 4}) returns Mat4[int]{ {2, 0, 0, 0}, {0, 3, 0, 0}, {0, 0, 4, 0}, {0, 0, 0, 1} }
+ */
+func ScaleMat4[T Numeric](v Vec3[T]) Mat4[T] {
+	return Mat4[T]{
+		{v.X, 0, 0, 0},
+		{0, v.Y, 0, 0},
+		{0, 0, v.Z, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+/**
+ * ShearMat4 returns a 4x4 shear matrix. Each factor names the axis being sheared followed by the axis it is sheared by,
+ * so xy adds xy*y to x.
+ * For example:
+ *   ShearMat4[int](1, 0, 0, 0, 0, 0) returns Mat4[int]{ {1, 1, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}, {0, 0, 0, 1} }
+ */
+func ShearMat4[T Numeric](xy, xz, yx, yz, zx, zy T) Mat4[T] {
+	return Mat4[T]{
+		{1, xy, xz, 0},
+		{yx, 1, yz, 0},
+		{zx, zy, 1, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+/**
+ * RotateMat4 returns a 4x4 rotation matrix for a rotation around axis by angle radians. The axis does not need to be normalized.
+ * For example:
+ *   RotateMat4(Vec3[float64]{0, 0, 1}, math.Pi/2) returns approximately IdentityMat4[float64]().RotateZ(math.Pi/2)
+ */
+func RotateMat4[T Numeric](axis Vec3[T], angle T) Mat4[T] {
+	return QuatFromAxisAngle(axis, angle).Mat4()
+}
+
+/**
+ * Translated returns the matrix multiplied on the right by a translation by v, so the translation is applied before m.
+ * For example:
+ *   IdentityMat4[int]().Translated(Vec3[int]{1, 2, 3}) returns TranslateMat4(Vec3[int]{1, 2, 3})
+ */
+func (m Mat4[T]) Translated(v Vec3[T]) Mat4[T] {
+	return m.Mul(TranslateMat4(v))
+}
+
+/**
+ * Rotated returns the matrix multiplied on the right by a rotation around axis by angle radians, so the rotation is applied before m.
+ * For example:
+ *   TranslateMat4(Vec3[float64]{1, 0, 0}).Rotated(Vec3[float64]{0, 0, 1}, math.Pi/2) rotates points and then moves them by 1 along X
+ */
+func (m Mat4[T]) Rotated(axis Vec3[T], angle T) Mat4[T] {
+	return m.Mul(RotateMat4(axis, angle))
+}
+
+/**
+ * Scaled returns the matrix multiplied on the right by a scale by v, so the scale is applied before m.
+ * For example:
+ *   TranslateMat4(Vec3[int]{1, 2, 3}).Scaled(Vec3[int]{2, 2, 2}) returns Mat4[int]{ {2, 0, 0, 1}, {0, 2, 0, 2}, {0, 0, 2, 3}, {0, 0, 0, 1} }
+ */
+func (m Mat4[T]) Scaled(v Vec3[T]) Mat4[T] {
+	return m.Mul(ScaleMat4(v))
+}
+
+/**
+ * Sheared returns the matrix multiplied on the right by ShearMat4(xy, xz, yx, yz, zx, zy), so the shear is applied before m.
+ * For example:
+ *   IdentityMat4[int]().Sheared(1, 0, 0, 0, 0, 0) returns ShearMat4[int](1, 0, 0, 0, 0, 0)
+ */
+func (m Mat4[T]) Sheared(xy, xz, yx, yz, zx, zy T) Mat4[T] {
+	return m.Mul(ShearMat4(xy, xz, yx, yz, zx, zy))
+}
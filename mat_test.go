@@ -130,3 +130,210 @@ func TestMat4RotationZ(t *testing.T) {
 		t.Errorf("Mat4 RotateZ() = %v, want %v", rotated, expected)
 	}
 }
+
+// approxEqual reports whether a and b differ by at most eps.
+func approxEqual(a, b, eps float64) bool {
+	return Abs(a-b) <= eps
+}
+
+// mat3ApproxEqual reports whether every element of a and b differ by at most eps.
+func mat3ApproxEqual(a, b Mat3[float64], eps float64) bool {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if !approxEqual(a[i][j], b[i][j], eps) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// mat4ApproxEqual reports whether every element of a and b differ by at most eps.
+func mat4ApproxEqual(a, b Mat4[float64], eps float64) bool {
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if !approxEqual(a[i][j], b[i][j], eps) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// vec3ApproxEqual reports whether every component of a and b differ by at most eps.
+func vec3ApproxEqual(a, b Vec3[float64], eps float64) bool {
+	return approxEqual(a.X, b.X, eps) && approxEqual(a.Y, b.Y, eps) && approxEqual(a.Z, b.Z, eps)
+}
+
+// TestMat4Perspective tests the perspective projection builders against reference matrices.
+func TestMat4Perspective(t *testing.T) {
+	tests := []struct {
+		name     string
+		got      Mat4[float64]
+		expected Mat4[float64]
+	}{
+		{
+			"Perspective",
+			Perspective(Pi/2, 2, 1, 3),
+			Mat4[float64]{{0.5, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, -2, -3}, {0, 0, -1, 0}},
+		},
+		{
+			"PerspectiveInfinite",
+			PerspectiveInfinite(Pi/2, 1, 0.1),
+			Mat4[float64]{{1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, -1, -0.2}, {0, 0, -1, 0}},
+		},
+		{
+			"PerspectiveReversedZ",
+			PerspectiveReversedZ(Pi/2, 1, 1, 3),
+			Mat4[float64]{{1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 0.5, 1.5}, {0, 0, -1, 0}},
+		},
+		{
+			"PerspectiveInfiniteReversedZ",
+			PerspectiveInfiniteReversedZ(Pi/2, 1, 0.1),
+			Mat4[float64]{{1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 0, 0.1}, {0, 0, -1, 0}},
+		},
+		{
+			"FrustumMat4",
+			FrustumMat4(-1.0, 3, -2, 2, 1, 3),
+			Mat4[float64]{{0.5, 0, 0.5, 0}, {0, 0.5, 0, 0}, {0, 0, -2, -3}, {0, 0, -1, 0}},
+		},
+		{
+			"Orthographic",
+			Orthographic(0.0, 4, 0, 2, 1, 3),
+			Mat4[float64]{{0.5, 0, 0, -1}, {0, 1, 0, -1}, {0, 0, -1, -2}, {0, 0, 0, 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		if !mat4ApproxEqual(tt.got, tt.expected, 1e-12) {
+			t.Errorf("Mat4 %s() = %v, want %v", tt.name, tt.got, tt.expected)
+		}
+	}
+}
+
+// TestMat4LookAt tests the view matrix builder against a reference matrix.
+func TestMat4LookAt(t *testing.T) {
+	expected := Mat4[float64]{
+		{0, 0, -1, 0},
+		{0, 1, 0, 0},
+		{1, 0, 0, -5},
+		{0, 0, 0, 1},
+	}
+	view := LookAt(NewVec3[float64](5, 0, 0), NewVec3[float64](0, 0, 0), NewVec3[float64](0, 1, 0))
+
+	if !mat4ApproxEqual(view, expected, 1e-12) {
+		t.Errorf("Mat4 LookAt() = %v, want %v", view, expected)
+	}
+}
+
+// TestMatMulVec tests matrix-vector multiplication for Mat2, Mat3 and Mat4.
+func TestMatMulVec(t *testing.T) {
+	if got, want := (Mat2[int]{{1, 2}, {3, 4}}).MulVec(Vec2[int]{5, 6}), (Vec2[int]{17, 39}); got != want {
+		t.Errorf("Mat2 MulVec() = %v, want %v", got, want)
+	}
+	if got, want := (Mat2[int]{{1, 2}, {3, 4}}).VecMul(Vec2[int]{5, 6}), (Vec2[int]{23, 34}); got != want {
+		t.Errorf("Mat2 VecMul() = %v, want %v", got, want)
+	}
+	m3 := Mat3[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	if got, want := m3.MulVec(Vec3[int]{1, 0, 2}), (Vec3[int]{7, 16, 25}); got != want {
+		t.Errorf("Mat3 MulVec() = %v, want %v", got, want)
+	}
+	if got, want := m3.VecMul(Vec3[int]{1, 0, 2}), (Vec3[int]{15, 18, 21}); got != want {
+		t.Errorf("Mat3 VecMul() = %v, want %v", got, want)
+	}
+	m4 := Mat4[int]{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10, 11, 12}, {13, 14, 15, 16}}
+	if got, want := m4.MulVec(Vec4[int]{1, 0, 0, 1}), (Vec4[int]{5, 13, 21, 29}); got != want {
+		t.Errorf("Mat4 MulVec() = %v, want %v", got, want)
+	}
+	if got, want := m4.VecMul(Vec4[int]{1, 0, 0, 1}), (Vec4[int]{14, 16, 18, 20}); got != want {
+		t.Errorf("Mat4 VecMul() = %v, want %v", got, want)
+	}
+}
+
+// TestMat4TransformPoint tests point and direction transforms, including the perspective divide.
+func TestMat4TransformPoint(t *testing.T) {
+	proj := Perspective(Pi/2, 1, 1, 3)
+	if got, want := proj.TransformPoint(NewVec3[float64](0, 0, -3)), NewVec3[float64](0, 0, 1); !vec3ApproxEqual(got, want, 1e-12) {
+		t.Errorf("Mat4 TransformPoint() = %v, want %v", got, want)
+	}
+	if got, want := proj.TransformPoint(NewVec3[float64](2, 1, -2)), NewVec3[float64](1, 0.5, 0.5); !vec3ApproxEqual(got, want, 1e-12) {
+		t.Errorf("Mat4 TransformPoint() = %v, want %v", got, want)
+	}
+
+	rot := IdentityMat4[float64]().RotateZ(Pi / 2)
+	rot[0][3] = 10
+	if got, want := rot.TransformDirection(NewVec3[float64](1, 0, 0)), NewVec3[float64](0, 1, 0); !vec3ApproxEqual(got, want, 1e-12) {
+		t.Errorf("Mat4 TransformDirection() = %v, want %v", got, want)
+	}
+}
+
+// TestMat4Compose tests chaining translation, rotation and scale on Mat4.
+func TestMat4Compose(t *testing.T) {
+	m := IdentityMat4[float64]().
+		Translated(NewVec3[float64](1, 2, 3)).
+		Rotated(NewVec3[float64](0, 0, 1), Pi/2).
+		Scaled(NewVec3[float64](2, 2, 2))
+	expected := TranslateMat4(NewVec3[float64](1, 2, 3)).
+		Mul(IdentityMat4[float64]().RotateZ(Pi / 2)).
+		Mul(ScaleMat4(NewVec3[float64](2, 2, 2)))
+
+	if !mat4ApproxEqual(m, expected, 1e-12) {
+		t.Errorf("Mat4 Translated().Rotated().Scaled() = %v, want %v", m, expected)
+	}
+	if got, want := m.TransformPoint(NewVec3[float64](1, 0, 0)), NewVec3[float64](1, 4, 3); !vec3ApproxEqual(got, want, 1e-12) {
+		t.Errorf("Mat4 TransformPoint() = %v, want %v", got, want)
+	}
+	if got, want := ShearMat4[int](1, 0, 0, 0, 0, 0).TransformPoint(Vec3[int]{1, 2, 3}), (Vec3[int]{3, 2, 3}); got != want {
+		t.Errorf("Mat4 ShearMat4() = %v, want %v", got, want)
+	}
+}
+
+// TestRotateComposesWithReceiver tests that the axis rotation methods compose with the receiver instead of replacing it.
+func TestRotateComposesWithReceiver(t *testing.T) {
+	m4 := TranslateMat4(NewVec3[float64](1, 2, 3))
+	tests4 := []struct {
+		name     string
+		got, exp Mat4[float64]
+	}{
+		{"RotateX", m4.RotateX(Pi / 3), m4.Rotated(NewVec3[float64](1, 0, 0), Pi/3)},
+		{"RotateY", m4.RotateY(Pi / 3), m4.Rotated(NewVec3[float64](0, 1, 0), Pi/3)},
+		{"RotateZ", m4.RotateZ(Pi / 3), m4.Rotated(NewVec3[float64](0, 0, 1), Pi/3)},
+	}
+	for _, tt := range tests4 {
+		if !mat4ApproxEqual(tt.got, tt.exp, 1e-12) {
+			t.Errorf("Mat4 %s() = %v, want %v", tt.name, tt.got, tt.exp)
+		}
+	}
+
+	m3 := ScaleMat3(NewVec3[float64](2, 3, 4))
+	tests3 := []struct {
+		name     string
+		got, exp Mat3[float64]
+	}{
+		{"RotateX", m3.RotateX(Pi / 3), m3.Mul(RotateX(Pi / 3))},
+		{"RotateY", m3.RotateY(Pi / 3), m3.Mul(RotateY(Pi / 3))},
+		{"RotateZ", m3.RotateZ(Pi / 3), m3.Mul(RotateZ(Pi / 3))},
+		{"Sheared", m3.Sheared(1, 0, 0, 2, 0, 0), m3.Mul(ShearMat3(1, 0, 0, 2, 0, 0.0))},
+	}
+	for _, tt := range tests3 {
+		if !mat3ApproxEqual(tt.got, tt.exp, 1e-12) {
+			t.Errorf("Mat3 %s() = %v, want %v", tt.name, tt.got, tt.exp)
+		}
+	}
+	if got, want := IdentityMat3[int]().Sheared(1, 0, 0, 0, 0, 0).MulVec(Vec3[int]{1, 2, 3}), (Vec3[int]{3, 2, 3}); got != want {
+		t.Errorf("Mat3 Sheared() = %v, want %v", got, want)
+	}
+}
+
+// TestMat3Affine2D tests the 2D homogeneous builders on Mat3.
+func TestMat3Affine2D(t *testing.T) {
+	m := Translate2D(NewVec2[float64](1, 2)).Rotated2D(Pi / 2).Scaled2D(NewVec2[float64](2, 3))
+	p := m.TransformPoint2D(NewVec2[float64](1, 1))
+
+	if !approxEqual(p.X, -2, 1e-12) || !approxEqual(p.Y, 4, 1e-12) {
+		t.Errorf("Mat3 TransformPoint2D() = %v, want %v", p, NewVec2[float64](-2, 4))
+	}
+	if got, want := Translate2D(Vec2[int]{1, 2}).TransformDirection2D(Vec2[int]{3, 4}), (Vec2[int]{3, 4}); got != want {
+		t.Errorf("Mat3 TransformDirection2D() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,409 @@
+package bm
+
+import (
+	"math"
+)
+
+const (
+	// expPadeDegree is the degree of the diagonal Padé approximant used by Exp after scaling the norm below one half.
+	expPadeDegree = 6
+	// logPadeDegree is the number of Gauss–Legendre nodes, and so the Padé degree, used by Log.
+	logPadeDegree = 8
+	// logRootThreshold is the norm of A - I below which Log stops taking square roots.
+	logRootThreshold = 0.25
+	// logMaxRoots bounds the number of square roots Log takes before giving up.
+	logMaxRoots = 64
+	// sqrtMaxIterations bounds the Denman–Beavers iterations taken by Sqrt.
+	sqrtMaxIterations = 100
+	// sqrtTolerance is the relative change between Denman–Beavers iterates at which Sqrt stops.
+	sqrtTolerance = 1e-14
+)
+
+/**
+ * Exp returns the matrix exponential e^d, computed in float64 with a Padé approximant and scaling and squaring.
+ * It panics if the matrix is not square.
+ * For example:
+ *   NewDense(2, 2, []float64{0, -math.Pi / 2, math.Pi / 2, 0}).Exp() returns approximately the matrix [[0, -1], [1, 0]]
+ */
+func (d Dense[T]) Exp() Dense[T] {
+	d.mustSquare()
+	return denseFromFloat64[T](expm(denseToFloat64(d)))
+}
+
+/**
+ * Log returns the principal matrix logarithm of d, computed in float64 by inverse scaling and squaring.
+ * It returns ErrSingular if d is singular and ErrNoConvergence if d has no real principal logarithm, as happens when
+ * it has eigenvalues on the negative real axis. It panics if the matrix is not square.
+ * For example:
+ *   NewDense(2, 2, []float64{0, -1, 1, 0}).Log() returns approximately (the matrix [[0, -π/2], [π/2, 0]], nil)
+ */
+func (d Dense[T]) Log() (Dense[T], error) {
+	d.mustSquare()
+	l, err := logm(denseToFloat64(d))
+	if err != nil {
+		return Dense[T]{}, err
+	}
+	return denseFromFloat64[T](l), nil
+}
+
+/**
+ * Sqrt returns the principal matrix square root of d, computed in float64 with the scaled Denman–Beavers iteration.
+ * It returns ErrSingular if d is singular and ErrNoConvergence if d has no real principal square root, as happens
+ * when it has eigenvalues on the negative real axis. It panics if the matrix is not square.
+ * For example:
+ *   NewDense(2, 2, []float64{4, 0, 0, 9}).Sqrt() returns (the matrix [[2, 0], [0, 3]], nil)
+ */
+func (d Dense[T]) Sqrt() (Dense[T], error) {
+	d.mustSquare()
+	s, err := sqrtm(denseToFloat64(d))
+	if err != nil {
+		return Dense[T]{}, err
+	}
+	return denseFromFloat64[T](s), nil
+}
+
+/**
+ * Pow returns d raised to the integer power n by repeated squaring. Negative powers use the inverse and return
+ * ErrSingular if d is singular. It panics if the matrix is not square.
+ * For example:
+ *   NewDense(2, 2, []int{1, 1, 1, 0}).Pow(10) returns (the matrix [[89, 55], [55, 34]], nil)
+ */
+func (d Dense[T]) Pow(n int) (Dense[T], error) {
+	d.mustSquare()
+	if n < 0 {
+		inv, ok := d.Inverse()
+		if !ok {
+			return Dense[T]{}, ErrSingular
+		}
+		d, n = inv, -n
+	}
+	return powBySquaring(d, IdentityDense[T](d.rows), n, Dense[T].Mul), nil
+}
+
+/**
+ * Exp returns the matrix exponential e^m.
+ * For example:
+ *   Mat2[float64]{ {0, -math.Pi / 2}, {math.Pi / 2, 0} }.Exp() returns approximately Mat2[float64]{ {0, -1}, {1, 0} }
+ */
+func (m Mat2[T]) Exp() Mat2[T] {
+	return DenseFromMat2(m).Exp().Mat2()
+}
+
+/**
+ * Exp returns the matrix exponential e^m. The exponential of a skew-symmetric matrix is a rotation.
+ * For example:
+ *   Mat3[float64]{ {0, -1, 0}, {1, 0, 0}, {0, 0, 0} }.Exp() returns approximately RotateMat3(Vec3[float64]{0, 0, 1}, 1)
+ */
+func (m Mat3[T]) Exp() Mat3[T] {
+	return DenseFromMat3(m).Exp().Mat3()
+}
+
+/**
+ * Exp returns the matrix exponential e^m, which maps a twist in se(3) to a rigid transform.
+ * For example:
+ *   Mat4[float64]{ {0, 0, 0, 1}, {0, 0, 0, 2}, {0, 0, 0, 3}, {0, 0, 0, 0} }.Exp() returns TranslateMat4(Vec3[float64]{1, 2, 3})
+ */
+func (m Mat4[T]) Exp() Mat4[T] {
+	return DenseFromMat4(m).Exp().Mat4()
+}
+
+/**
+ * Log returns the principal matrix logarithm of m, or ErrSingular or ErrNoConvergence as Dense.Log does.
+ * For example:
+ *   Mat2[float64]{ {0, -1}, {1, 0} }.Log() returns approximately (Mat2[float64]{ {0, -math.Pi / 2}, {math.Pi / 2, 0} }, nil)
+ */
+func (m Mat2[T]) Log() (Mat2[T], error) {
+	l, err := DenseFromMat2(m).Log()
+	if err != nil {
+		return Mat2[T]{}, err
+	}
+	return l.Mat2(), nil
+}
+
+/**
+ * Log returns the principal matrix logarithm of m, or ErrSingular or ErrNoConvergence as Dense.Log does.
+ * The logarithm of a rotation by less than π is the skew-symmetric matrix of its axis times its angle.
+ * For example:
+ *   RotateMat3(Vec3[float64]{0, 0, 1}, 1).Log() returns approximately (Mat3[float64]{ {0, -1, 0}, {1, 0, 0}, {0, 0, 0} }, nil)
+ */
+func (m Mat3[T]) Log() (Mat3[T], error) {
+	l, err := DenseFromMat3(m).Log()
+	if err != nil {
+		return Mat3[T]{}, err
+	}
+	return l.Mat3(), nil
+}
+
+/**
+ * Log returns the principal matrix logarithm of m, or ErrSingular or ErrNoConvergence as Dense.Log does.
+ * Interpolating rigid transforms as a.Mul(d.Log().ScalarMul(t).Exp()), where d is the relative transform from a to b, follows a screw motion.
+ * For example:
+ *   TranslateMat4(Vec3[float64]{1, 2, 3}).Log() returns approximately (Mat4[float64]{ {0, 0, 0, 1}, {0, 0, 0, 2}, {0, 0, 0, 3}, {0, 0, 0, 0} }, nil)
+ */
+func (m Mat4[T]) Log() (Mat4[T], error) {
+	l, err := DenseFromMat4(m).Log()
+	if err != nil {
+		return Mat4[T]{}, err
+	}
+	return l.Mat4(), nil
+}
+
+/**
+ * Sqrt returns the principal matrix square root of m, or ErrSingular or ErrNoConvergence as Dense.Sqrt does.
+ * For example:
+ *   Mat2[float64]{ {4, 0}, {0, 9} }.Sqrt() returns (Mat2[float64]{ {2, 0}, {0, 3} }, nil)
+ */
+func (m Mat2[T]) Sqrt() (Mat2[T], error) {
+	s, err := DenseFromMat2(m).Sqrt()
+	if err != nil {
+		return Mat2[T]{}, err
+	}
+	return s.Mat2(), nil
+}
+
+/**
+ * Sqrt returns the principal matrix square root of m, or ErrSingular or ErrNoConvergence as Dense.Sqrt does.
+ * The square root of a rotation by less than π is the rotation by half the angle.
+ * For example:
+ *   RotateMat3(Vec3[float64]{0, 0, 1}, 1).Sqrt() returns approximately (RotateMat3(Vec3[float64]{0, 0, 1}, 0.5), nil)
+ */
+func (m Mat3[T]) Sqrt() (Mat3[T], error) {
+	s, err := DenseFromMat3(m).Sqrt()
+	if err != nil {
+		return Mat3[T]{}, err
+	}
+	return s.Mat3(), nil
+}
+
+/**
+ * Sqrt returns the principal matrix square root of m, or ErrSingular or ErrNoConvergence as Dense.Sqrt does.
+ * For example:
+ *   ScaleMat4(Vec3[float64]{4, 9, 16}).Sqrt() returns (ScaleMat4(Vec3[float64]{2, 3, 4}), nil)
+ */
+func (m Mat4[T]) Sqrt() (Mat4[T], error) {
+	s, err := DenseFromMat4(m).Sqrt()
+	if err != nil {
+		return Mat4[T]{}, err
+	}
+	return s.Mat4(), nil
+}
+
+/**
+ * Pow returns m raised to the integer power n by repeated squaring. Negative powers use the inverse and return
+ * ErrSingular if m is singular.
+ * For example:
+ *   Mat2[int]{ {1, 1}, {1, 0} }.Pow(10) returns (Mat2[int]{ {89, 55}, {55, 34} }, nil)
+ */
+func (m Mat2[T]) Pow(n int) (Mat2[T], error) {
+	if n < 0 {
+		inv, ok := m.Inverse()
+		if !ok {
+			return Mat2[T]{}, ErrSingular
+		}
+		m, n = inv, -n
+	}
+	return powBySquaring(m, IdentityMat2[T](), n, Mat2[T].Mul), nil
+}
+
+/**
+ * Pow returns m raised to the integer power n by repeated squaring. Negative powers use the inverse and return
+ * ErrSingular if m is singular.
+ * For example:
+ *   ScaleMat3(Vec3[int]{1, 2, 3}).Pow(2) returns (ScaleMat3(Vec3[int]{1, 4, 9}), nil)
+ */
+func (m Mat3[T]) Pow(n int) (Mat3[T], error) {
+	if n < 0 {
+		inv, ok := m.Inverse()
+		if !ok {
+			return Mat3[T]{}, ErrSingular
+		}
+		m, n = inv, -n
+	}
+	return powBySquaring(m, IdentityMat3[T](), n, Mat3[T].Mul), nil
+}
+
+/**
+ * Pow returns m raised to the integer power n by repeated squaring. Negative powers use the inverse and return
+ * ErrSingular if m is singular.
+ * For example:
+ *   TranslateMat4(Vec3[float64]{1, 0, 0}).Pow(-3) returns (TranslateMat4(Vec3[float64]{-3, 0, 0}), nil)
+ */
+func (m Mat4[T]) Pow(n int) (Mat4[T], error) {
+	if n < 0 {
+		inv, ok := m.Inverse()
+		if !ok {
+			return Mat4[T]{}, ErrSingular
+		}
+		m, n = inv, -n
+	}
+	return powBySquaring(m, IdentityMat4[T](), n, Mat4[T].Mul), nil
+}
+
+// powBySquaring returns m to the non-negative power n, given the identity and the product.
+func powBySquaring[M any](m, identity M, n int, mul func(a, b M) M) M {
+	result := identity
+	for n > 0 {
+		if n&1 == 1 {
+			result = mul(result, m)
+		}
+		m = mul(m, m)
+		n >>= 1
+	}
+	return result
+}
+
+// expm scales a until its infinity norm is at most one half, evaluates the diagonal Padé approximant of degree
+// expPadeDegree and squares the result back, following Golub and Van Loan.
+func expm(a Dense[float64]) Dense[float64] {
+	n := a.rows
+	s := 0
+	if norm := normInf(a); norm > 0.5 {
+		s = int(math.Ceil(math.Log2(norm / 0.5)))
+	}
+	a = a.Scale(math.Ldexp(1, -s))
+
+	q := expPadeDegree
+	c := 0.5
+	x := a
+	num := IdentityDense[float64](n).Add(a.Scale(c))
+	den := IdentityDense[float64](n).Sub(a.Scale(c))
+	for k := 2; k <= q; k++ {
+		c *= float64(q-k+1) / float64(k*(2*q-k+1))
+		x = a.Mul(x)
+		num = num.Add(x.Scale(c))
+		if k%2 == 0 {
+			den = den.Add(x.Scale(c))
+		} else {
+			den = den.Sub(x.Scale(c))
+		}
+	}
+	// The denominator is close to the identity after scaling, so it is never singular.
+	f, _ := den.LU().SolveDense(num)
+	for ; s > 0; s-- {
+		f = f.Mul(f)
+	}
+	return f
+}
+
+// logm takes square roots of a until it is close to the identity, evaluates the Padé approximant of log(I + X) as a
+// Gauss–Legendre quadrature and scales the result back, following Higham's inverse scaling and squaring.
+func logm(a Dense[float64]) (Dense[float64], error) {
+	n := a.rows
+	identity := IdentityDense[float64](n)
+	if a.LU().Singular() {
+		return Dense[float64]{}, ErrSingular
+	}
+	roots := 0
+	for normInf(a.Sub(identity)) > logRootThreshold {
+		if roots == logMaxRoots {
+			return Dense[float64]{}, ErrNoConvergence
+		}
+		var err error
+		if a, err = sqrtm(a); err != nil {
+			return Dense[float64]{}, err
+		}
+		roots++
+	}
+
+	x := a.Sub(identity)
+	nodes, weights := gaussLegendre(logPadeDegree)
+	l := NewDense[float64](n, n, nil)
+	for j, t := range nodes {
+		term, err := identity.Add(x.Scale(t)).LU().SolveDense(x)
+		if err != nil {
+			return Dense[float64]{}, ErrNoConvergence
+		}
+		l = l.Add(term.Scale(weights[j]))
+	}
+	return l.Scale(math.Ldexp(1, roots)), nil
+}
+
+// sqrtm computes the principal square root of a with the Denman–Beavers iteration, scaled by the determinants of the
+// iterates to speed up the early steps.
+func sqrtm(a Dense[float64]) (Dense[float64], error) {
+	n := a.rows
+	if a.LU().Singular() {
+		return Dense[float64]{}, ErrSingular
+	}
+	y, z := a.Clone(), IdentityDense[float64](n)
+	for iter := 0; iter < sqrtMaxIterations; iter++ {
+		yf, zf := y.LU(), z.LU()
+		yinv, err := yf.Inverse()
+		if err != nil {
+			return Dense[float64]{}, ErrNoConvergence
+		}
+		zinv, err := zf.Inverse()
+		if err != nil {
+			return Dense[float64]{}, ErrNoConvergence
+		}
+		g := math.Pow(math.Abs(yf.Determinant()*zf.Determinant()), -1/float64(2*n))
+		if math.IsInf(g, 0) || math.IsNaN(g) || g == 0 {
+			g = 1
+		}
+		next := y.Scale(g).Add(zinv.Scale(1 / g)).Scale(0.5)
+		z = z.Scale(g).Add(yinv.Scale(1 / g)).Scale(0.5)
+		change := next.Sub(y).Norm()
+		y = next
+		if change <= sqrtTolerance*y.Norm() {
+			return y, nil
+		}
+	}
+	return Dense[float64]{}, ErrNoConvergence
+}
+
+// gaussLegendre returns the nodes and weights of the m-point Gauss–Legendre rule on [0, 1], finding the roots of the
+// Legendre polynomial by Newton's method.
+func gaussLegendre(m int) ([]float64, []float64) {
+	nodes := make([]float64, m)
+	weights := make([]float64, m)
+	for i := 0; i < m; i++ {
+		z := math.Cos(math.Pi * (float64(i) + 0.75) / (float64(m) + 0.5))
+		var dp float64
+		for iter := 0; iter < 100; iter++ {
+			p, prev := 1.0, 0.0
+			for j := 1; j <= m; j++ {
+				p, prev = (float64(2*j-1)*z*p-float64(j-1)*prev)/float64(j), p
+			}
+			dp = float64(m) * (z*p - prev) / (z*z - 1)
+			dz := p / dp
+			z -= dz
+			if math.Abs(dz) < 1e-15 {
+				break
+			}
+		}
+		nodes[i] = (1 - z) / 2
+		weights[i] = 1 / ((1 - z*z) * dp * dp)
+	}
+	return nodes, weights
+}
+
+// normInf returns the maximum absolute row sum of d.
+func normInf(d Dense[float64]) float64 {
+	var norm float64
+	for i := 0; i < d.rows; i++ {
+		var sum float64
+		for _, v := range d.data[i*d.cols : (i+1)*d.cols] {
+			sum += math.Abs(v)
+		}
+		norm = math.Max(norm, sum)
+	}
+	return norm
+}
+
+// denseToFloat64 returns a float64 copy of d.
+func denseToFloat64[T Numeric](d Dense[T]) Dense[float64] {
+	out := NewDense[float64](d.rows, d.cols, nil)
+	for i, v := range d.data {
+		out.data[i] = float64(v)
+	}
+	return out
+}
+
+// denseFromFloat64 converts d back to element type T.
+func denseFromFloat64[T Numeric](d Dense[float64]) Dense[T] {
+	out := NewDense[T](d.rows, d.cols, nil)
+	for i, v := range d.data {
+		out.data[i] = T(v)
+	}
+	return out
+}
@@ -0,0 +1,129 @@
+package bm
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// TestMatExp tests the matrix exponential against closed forms.
+func TestMatExp(t *testing.T) {
+	z := Vec3[float64]{0, 0, 1}
+	tests := []struct {
+		name string
+		m    Mat3[float64]
+		want Mat3[float64]
+	}{
+		{"zero", Mat3[float64]{}, IdentityMat3[float64]()},
+		{"diagonal", ScaleMat3(Vec3[float64]{1, -2, 0.5}), ScaleMat3(Vec3[float64]{math.E, math.Exp(-2), math.Exp(0.5)})},
+		{"rotation generator", Mat3[float64]{{0, -1, 0}, {1, 0, 0}, {0, 0, 0}}, RotateMat3(z, 1)},
+		{"large rotation generator", Mat3[float64]{{0, -3, 0}, {3, 0, 0}, {0, 0, 0}}, RotateMat3(z, 3)},
+		{"nilpotent", Mat3[float64]{{0, 1, 2}, {0, 0, 3}, {0, 0, 0}}, Mat3[float64]{{1, 1, 3.5}, {0, 1, 3}, {0, 0, 1}}},
+	}
+	for _, tt := range tests {
+		if got := tt.m.Exp(); !mat3ApproxEqual(got, tt.want, 1e-13) {
+			t.Errorf("%s: Exp() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	m := Mat4[float64]{{0.1, 2, -1, 0}, {0.3, -0.5, 1, 4}, {-2, 0, 0.2, 1}, {0, 0, 0, 0}}
+	if got := m.Exp().Mul(m.ScalarMul(-1).Exp()); !mat4ApproxEqual(got, IdentityMat4[float64](), 1e-12) {
+		t.Errorf("Exp(M)*Exp(-M) = %v, want identity", got)
+	}
+	twist := Mat4[float64]{{0, 0, 0, 1}, {0, 0, 0, 2}, {0, 0, 0, 3}, {0, 0, 0, 0}}
+	if got := twist.Exp(); !mat4ApproxEqual(got, TranslateMat4(Vec3[float64]{1, 2, 3}), 1e-14) {
+		t.Errorf("Mat4 Exp() of a translation twist = %v, want a translation", got)
+	}
+	if got := (Mat2[float64]{{0, -math.Pi / 2}, {math.Pi / 2, 0}}).Exp(); !denseApproxEqual(DenseFromMat2(got), NewDense(2, 2, []float64{0, -1, 1, 0}), 1e-14) {
+		t.Errorf("Mat2 Exp() = %v, want a quarter turn", got)
+	}
+}
+
+// TestMatLogSqrt tests that Log and Sqrt invert Exp and squaring.
+func TestMatLogSqrt(t *testing.T) {
+	axis := Vec3[float64]{1, 2, 2}.Norm()
+	tests := []struct {
+		name string
+		m    Mat3[float64]
+	}{
+		{"rotation", RotateMat3(axis, 2.5)},
+		{"spd", Mat3[float64]{{4, 1, 0.5}, {1, 5, 2}, {0.5, 2, 6}}},
+		{"nonsymmetric", Mat3[float64]{{2, 1, 0}, {0, 3, 1}, {0.5, 0, 1}}},
+		{"large", ScaleMat3(Vec3[float64]{1e6, 1e-3, 2})},
+	}
+	for _, tt := range tests {
+		l, err := tt.m.Log()
+		if err != nil {
+			t.Errorf("%s: Log() error = %v", tt.name, err)
+		} else if got := l.Exp(); !mat3ApproxEqual(got, tt.m, 1e-9*tt.m.Norm()) {
+			t.Errorf("%s: Exp(Log(M)) = %v, want %v", tt.name, got, tt.m)
+		}
+		s, err := tt.m.Sqrt()
+		if err != nil {
+			t.Errorf("%s: Sqrt() error = %v", tt.name, err)
+		} else if got := s.Mul(s); !mat3ApproxEqual(got, tt.m, 1e-12*tt.m.Norm()) {
+			t.Errorf("%s: Sqrt(M)² = %v, want %v", tt.name, got, tt.m)
+		}
+	}
+
+	if got, err := RotateMat3(axis, 2.5).Log(); err != nil || !mat3ApproxEqual(got, Mat3[float64]{{0, -axis.Z, axis.Y}, {axis.Z, 0, -axis.X}, {-axis.Y, axis.X, 0}}.ScalarMul(2.5), 1e-12) {
+		t.Errorf("Log() of a rotation = (%v, %v), want the scaled axis generator", got, err)
+	}
+	if got, err := RotateMat3(axis, 2.5).Sqrt(); err != nil || !mat3ApproxEqual(got, RotateMat3(axis, 1.25), 1e-12) {
+		t.Errorf("Sqrt() of a rotation = (%v, %v), want the half rotation", got, err)
+	}
+	if got, err := TranslateMat4(Vec3[float64]{1, 2, 3}).Log(); err != nil || !mat4ApproxEqual(got, Mat4[float64]{{0, 0, 0, 1}, {0, 0, 0, 2}, {0, 0, 0, 3}, {0, 0, 0, 0}}, 1e-12) {
+		t.Errorf("Mat4 Log() of a translation = (%v, %v), want the translation twist", got, err)
+	}
+	if got, err := (Mat2[float64]{{4, 0}, {0, 9}}).Sqrt(); err != nil || got != (Mat2[float64]{{2, 0}, {0, 3}}) {
+		t.Errorf("Mat2 Sqrt() = (%v, %v), want {{2, 0}, {0, 3}}", got, err)
+	}
+
+	errTests := []struct {
+		name string
+		m    Mat2[float64]
+		err  error
+	}{
+		{"singular", Mat2[float64]{{1, 2}, {2, 4}}, ErrSingular},
+		{"negative identity", Mat2[float64]{{-1, 0}, {0, -1}}, ErrNoConvergence},
+		{"negative eigenvalue", Mat2[float64]{{-4, 0}, {0, 1}}, ErrNoConvergence},
+	}
+	for _, tt := range errTests {
+		if _, err := tt.m.Sqrt(); !errors.Is(err, tt.err) {
+			t.Errorf("%s: Sqrt() error = %v, want %v", tt.name, err, tt.err)
+		}
+		if _, err := tt.m.Log(); !errors.Is(err, tt.err) {
+			t.Errorf("%s: Log() error = %v, want %v", tt.name, err, tt.err)
+		}
+	}
+}
+
+// TestMatPow tests integer powers, including negative powers and integer matrices.
+func TestMatPow(t *testing.T) {
+	if got, err := (Mat2[int]{{1, 1}, {1, 0}}).Pow(10); err != nil || got != (Mat2[int]{{89, 55}, {55, 34}}) {
+		t.Errorf("Mat2 Pow(10) = (%v, %v), want Fibonacci numbers", got, err)
+	}
+	if got, err := (Mat2[int]{{1, 1}, {1, 0}}).Pow(0); err != nil || got != IdentityMat2[int]() {
+		t.Errorf("Mat2 Pow(0) = (%v, %v), want identity", got, err)
+	}
+	m := Mat3[float64]{{2, 1, 0}, {0, 3, 1}, {0.5, 0, 1}}
+	if got, err := m.Pow(3); err != nil || !mat3ApproxEqual(got, m.Mul(m).Mul(m), 1e-12) {
+		t.Errorf("Mat3 Pow(3) = (%v, %v), want %v", got, err, m.Mul(m).Mul(m))
+	}
+	inv, _ := m.Inverse()
+	if got, err := m.Pow(-2); err != nil || !mat3ApproxEqual(got, inv.Mul(inv), 1e-12) {
+		t.Errorf("Mat3 Pow(-2) = (%v, %v), want %v", got, err, inv.Mul(inv))
+	}
+	if got, err := TranslateMat4(Vec3[float64]{1, 0, 0}).Pow(-3); err != nil || !mat4ApproxEqual(got, TranslateMat4(Vec3[float64]{-3, 0, 0}), 1e-12) {
+		t.Errorf("Mat4 Pow(-3) = (%v, %v), want a translation by -3", got, err)
+	}
+	if got, err := (Mat3[int]{{1, 2, 3}, {0, 1, 4}, {5, 6, 0}}).Pow(-1); err != nil || got != (Mat3[int]{{-24, 18, 5}, {20, -15, -4}, {-5, 4, 1}}) {
+		t.Errorf("Mat3[int] Pow(-1) = (%v, %v), want the integer inverse", got, err)
+	}
+	if _, err := (Mat4[float64]{}).Pow(-1); !errors.Is(err, ErrSingular) {
+		t.Errorf("Mat4 Pow(-1) of a singular matrix error = %v, want %v", err, ErrSingular)
+	}
+	if got, err := NewDense(2, 2, []float64{2, 0, 0, 3}).Pow(4); err != nil || !denseApproxEqual(got, NewDense(2, 2, []float64{16, 0, 0, 81}), 0) {
+		t.Errorf("Dense Pow(4) = (%v, %v), want [[16, 0], [0, 81]]", got, err)
+	}
+}
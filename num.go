@@ -1,7 +1,6 @@
 package bm
 
 import (
-	"log"
 	"math"
 )
 
@@ -157,6 +156,19 @@ func Sqrt[T Numeric](x T) T {
 	return T(math.Sqrt(float64(x)))
 }
 
+/**
+ * SqrtE returns the square root of x, or ErrDomain if x is negative.
+ * For example:
+ *   SqrtE(9) returns (3, nil)
+ *   SqrtE(-1) returns (0, ErrDomain)
+ */
+func SqrtE[T Numeric](x T) (T, error) {
+	if x < 0 {
+		return 0, ErrDomain
+	}
+	return T(math.Sqrt(float64(x))), nil
+}
+
 /**
  * Cbrt returns the cube root of x.
  * For example:
@@ -407,6 +419,19 @@ func Log[T Numeric](x T) T {
 	return T(math.Log(float64(x)))
 }
 
+/**
+ * LogE returns the natural logarithm (base e) of x, or ErrDomain if x is less than or equal to 0.
+ * For example:
+ *   LogE(1) returns (0, nil)
+ *   LogE(0) returns (0, ErrDomain)
+ */
+func LogE[T Numeric](x T) (T, error) {
+	if x <= 0 {
+		return 0, ErrDomain
+	}
+	return T(math.Log(float64(x))), nil
+}
+
 /**
  * Log2 returns the base-2 logarithm of x.
  * If x is less than or equal to 0, it returns 0.
@@ -421,6 +446,19 @@ func Log2[T Numeric](x T) T {
 	return T(math.Log2(float64(x)))
 }
 
+/**
+ * Log2E returns the base-2 logarithm of x, or ErrDomain if x is less than or equal to 0.
+ * For example:
+ *   Log2E(8) returns (3, nil)
+ *   Log2E(-2) returns (0, ErrDomain)
+ */
+func Log2E[T Numeric](x T) (T, error) {
+	if x <= 0 {
+		return 0, ErrDomain
+	}
+	return T(math.Log2(float64(x))), nil
+}
+
 /**
  * Log10 returns the base-10 logarithm of x.
  * If x is less than or equal to 0, it returns 0.
@@ -435,6 +473,19 @@ func Log10[T Numeric](x T) T {
 	return T(math.Log10(float64(x)))
 }
 
+/**
+ * Log10E returns the base-10 logarithm of x, or ErrDomain if x is less than or equal to 0.
+ * For example:
+ *   Log10E(100) returns (2, nil)
+ *   Log10E(0) returns (0, ErrDomain)
+ */
+func Log10E[T Numeric](x T) (T, error) {
+	if x <= 0 {
+		return 0, ErrDomain
+	}
+	return T(math.Log10(float64(x))), nil
+}
+
 /**
  * Gamma returns the gamma function of x.
  * For example:
@@ -498,18 +549,29 @@ func Bezier3[T Numeric](p0, p1, p2, p3 T, t float64) T {
  * spline coefficients a, b, c, d and x values.
  * For example:
  *   CubicSpline(x, a, b, c, d, 2.5) returns the interpolated value at x = 2.5
- * If x is out of the range of the provided x values, it returns 0.
+ * If the input lengths are invalid or x is out of the range of the provided x values, it returns 0; use CubicSplineE
+ * to tell these cases apart.
  */
 func CubicSpline[T Numeric](x []T, a, b, c, d []T, xVal T) T {
+	v, _ := CubicSplineE(x, a, b, c, d, xVal)
+	return v
+}
+
+/**
+ * CubicSplineE computes the value of a cubic spline interpolation like CubicSpline, returning ErrLength if the
+ * slices have different lengths or fewer than two knots and ErrOutOfRange if xVal lies outside the knots.
+ * For example:
+ *   CubicSplineE(x, a, b, c, d, 2.5) returns (the interpolated value at x = 2.5, nil)
+ *   CubicSplineE(x, a, b, c, d, x[0]-1) returns (0, ErrOutOfRange)
+ */
+func CubicSplineE[T Numeric](x []T, a, b, c, d []T, xVal T) (T, error) {
 	if len(x) != len(a) || len(x) != len(b) || len(x) != len(c) || len(x) != len(d) || len(x) < 2 {
-		log.Println("invalid input lengths")
-		return 0 // Default value for invalid input
+		return 0, ErrLength
 	}
 
 	n := len(x)
 	if xVal < x[0] || xVal > x[n-1] {
-		log.Println("xVal out of bounds")
-		return 0 // Default value for out-of-bounds
+		return 0, ErrOutOfRange
 	}
 
 	i := 0
@@ -518,5 +580,5 @@ func CubicSpline[T Numeric](x []T, a, b, c, d []T, xVal T) T {
 	}
 
 	h := float64(xVal) - float64(x[i])
-	return T(float64(a[i]) + float64(b[i])*h + float64(c[i])*h*h + float64(d[i])*h*h*h)
+	return T(float64(a[i]) + float64(b[i])*h + float64(c[i])*h*h + float64(d[i])*h*h*h), nil
 }
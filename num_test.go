@@ -0,0 +1,88 @@
+package bm
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestErrorVariants tests that the error-returning functions report invalid input with sentinel errors.
+func TestErrorVariants(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(float64) (float64, error)
+		x    float64
+		want float64
+		err  error
+	}{
+		{"SqrtE", SqrtE[float64], 9, 3, nil},
+		{"SqrtE negative", SqrtE[float64], -1, 0, ErrDomain},
+		{"SqrtE zero", SqrtE[float64], 0, 0, nil},
+		{"LogE", LogE[float64], E, 1, nil},
+		{"LogE zero", LogE[float64], 0, 0, ErrDomain},
+		{"Log2E", Log2E[float64], 8, 3, nil},
+		{"Log2E negative", Log2E[float64], -2, 0, ErrDomain},
+		{"Log10E", Log10E[float64], 100, 2, nil},
+		{"Log10E zero", Log10E[float64], 0, 0, ErrDomain},
+	}
+	for _, tt := range tests {
+		got, err := tt.f(tt.x)
+		if !errors.Is(err, tt.err) || !approxEqual(got, tt.want, 1e-15) {
+			t.Errorf("%s(%v) = (%v, %v), want (%v, %v)", tt.name, tt.x, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+// TestCubicSplineE tests evaluation and error reporting of piecewise cubic coefficients.
+func TestCubicSplineE(t *testing.T) {
+	// y = x² on [0, 1] and y = 1 + 2(x-1) on [1, 2].
+	x := []float64{0, 1, 2}
+	a := []float64{0, 1, 3}
+	b := []float64{0, 2, 0}
+	c := []float64{1, 0, 0}
+	d := []float64{0, 0, 0}
+
+	tests := []struct {
+		name string
+		x    []float64
+		xVal float64
+		want float64
+		err  error
+	}{
+		{"first segment", x, 0.5, 0.25, nil},
+		{"second segment", x, 1.5, 2, nil},
+		{"last knot", x, 2, 3, nil},
+		{"below range", x, -0.1, 0, ErrOutOfRange},
+		{"above range", x, 2.1, 0, ErrOutOfRange},
+		{"mismatched lengths", x[:2], 0.5, 0, ErrLength},
+	}
+	for _, tt := range tests {
+		got, err := CubicSplineE(tt.x, a, b, c, d, tt.xVal)
+		if !errors.Is(err, tt.err) || !approxEqual(got, tt.want, 1e-15) {
+			t.Errorf("%s: CubicSplineE() = (%v, %v), want (%v, %v)", tt.name, got, err, tt.want, tt.err)
+		}
+		if got := CubicSpline(tt.x, a, b, c, d, tt.xVal); !approxEqual(got, tt.want, 1e-15) {
+			t.Errorf("%s: CubicSpline() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestInverseE tests that singular matrices report ErrSingular.
+func TestInverseE(t *testing.T) {
+	if _, err := (Mat2[float64]{{1, 2}, {2, 4}}).InverseE(); !errors.Is(err, ErrSingular) {
+		t.Errorf("Mat2 InverseE() error = %v, want %v", err, ErrSingular)
+	}
+	if _, err := (Mat3[float64]{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}).InverseE(); !errors.Is(err, ErrSingular) {
+		t.Errorf("Mat3 InverseE() error = %v, want %v", err, ErrSingular)
+	}
+	if _, err := NewDense(2, 2, []float64{1, 2, 2, 4}).InverseE(); !errors.Is(err, ErrSingular) {
+		t.Errorf("Dense InverseE() error = %v, want %v", err, ErrSingular)
+	}
+	m := TranslateMat4(Vec3[float64]{1, 2, 3})
+	if got, err := m.InverseE(); err != nil || !mat4ApproxEqual(got, TranslateMat4(Vec3[float64]{-1, -2, -3}), 1e-15) {
+		t.Errorf("Mat4 InverseE() = (%v, %v), want the opposite translation", got, err)
+	}
+	want := Mat3[int]{{-24, 18, 5}, {20, -15, -4}, {-5, 4, 1}}
+	if got, err := (Mat3[int]{{1, 2, 3}, {0, 1, 4}, {5, 6, 0}}).InverseE(); err != nil || got != want {
+		t.Errorf("Mat3[int] InverseE() = (%v, %v), want (%v, nil)", got, err, want)
+	}
+}
@@ -0,0 +1,618 @@
+package bm
+
+import (
+	"math"
+	"sort"
+)
+
+// NURBSCurve is a non-uniform rational B-spline curve in 3D. Its control points are held in homogeneous form
+// {w·x, w·y, w·z, w}, in which the curve is an ordinary B-spline that is projected back to 3D by dividing by w.
+// Rational weights let it represent conics such as circles exactly. Computation is done in float64.
+type NURBSCurve[T Numeric] struct {
+	degree int
+	knots  []float64
+	points []Vec4[float64]
+}
+
+// NURBSSurface is a tensor-product non-uniform rational B-spline surface in 3D. Its control net is indexed as
+// points[i][j], with i running along the u direction and j along the v direction, and is held in homogeneous form as
+// for NURBSCurve.
+type NURBSSurface[T Numeric] struct {
+	degreeU, degreeV int
+	knotsU, knotsV   []float64
+	points           [][]Vec4[float64]
+}
+
+/**
+ * NewNURBSCurve returns the NURBS curve of the given degree with the knot vector, control points and weights. A nil
+ * weights slice makes every weight one, giving a polynomial B-spline. The knot vector must be non-decreasing, repeat no
+ * knot more than degree+1 times and hold len(points)+degree+1 knots.
+ * It returns ErrDomain if the degree is below one or a weight is not positive, ErrLength if there are fewer than
+ * degree+1 control points or the weights do not match them, and ErrKnotVector for an invalid knot vector.
+ * For example:
+ *   NewNURBSCurve(2, []float64{0, 0, 0, 1, 1, 1}, []Vec3[float64]{{1, 0, 0}, {1, 1, 0}, {0, 1, 0}}, []float64{1, math.Sqrt2 / 2, 1}) returns a quarter of the unit circle
+ */
+func NewNURBSCurve[T Numeric](degree int, knots []T, points []Vec3[T], weights []T) (NURBSCurve[T], error) {
+	if weights != nil && len(weights) != len(points) {
+		return NURBSCurve[T]{}, ErrLength
+	}
+	return NewNURBSCurveHomogeneous(degree, knots, weighted(points, weights))
+}
+
+/**
+ * NewNURBSCurveHomogeneous returns the NURBS curve with control points already in homogeneous form {w·x, w·y, w·z, w}.
+ * It returns errors as NewNURBSCurve does.
+ * For example:
+ *   NewNURBSCurveHomogeneous(1, []float64{0, 0, 1, 1}, []Vec4[float64]{{0, 0, 0, 1}, {2, 0, 0, 2}}) returns the segment from the origin to {1, 0, 0}
+ */
+func NewNURBSCurveHomogeneous[T Numeric](degree int, knots []T, points []Vec4[T]) (NURBSCurve[T], error) {
+	ks, err := checkKnotVector(degree, knots, len(points))
+	if err != nil {
+		return NURBSCurve[T]{}, err
+	}
+	pts, err := homogeneousToFloat64(points)
+	if err != nil {
+		return NURBSCurve[T]{}, err
+	}
+	return NURBSCurve[T]{degree: degree, knots: ks, points: pts}, nil
+}
+
+/**
+ * Degree returns the polynomial degree of the curve.
+ * For example:
+ *   a quadratic circular arc returns 2
+ */
+func (c NURBSCurve[T]) Degree() int {
+	return c.degree
+}
+
+/**
+ * Knots returns a copy of the knot vector.
+ * For example:
+ *   a single-span quadratic curve returns []float64{0, 0, 0, 1, 1, 1}
+ */
+func (c NURBSCurve[T]) Knots() []T {
+	return toT[T](c.knots)
+}
+
+/**
+ * ControlPoints returns a copy of the control points in homogeneous form {w·x, w·y, w·z, w}.
+ * For example:
+ *   a control point {1, 1, 0} with weight 0.5 is returned as Vec4[float64]{0.5, 0.5, 0, 0.5}
+ */
+func (c NURBSCurve[T]) ControlPoints() []Vec4[T] {
+	return homogeneousToT[T](c.points)
+}
+
+/**
+ * Domain returns the parameter range of the curve, from knot degree to knot len(points).
+ * For example:
+ *   a curve with knots {0, 0, 0, 0.5, 1, 1, 1} and degree 2 returns (0, 1)
+ */
+func (c NURBSCurve[T]) Domain() (T, T) {
+	return T(c.knots[c.degree]), T(c.knots[len(c.points)])
+}
+
+/**
+ * At returns the point of the curve at parameter u, which is clamped to the domain.
+ * For example:
+ *   the quarter circle from NewNURBSCurve returns Vec3[float64]{math.Sqrt2 / 2, math.Sqrt2 / 2, 0} at u = 0.5
+ */
+func (c NURBSCurve[T]) At(u T) Vec3[T] {
+	return c.Derivatives(u, 0)[0]
+}
+
+/**
+ * Derivative returns the first derivative of the curve with respect to u.
+ * For example:
+ *   the quarter circle from NewNURBSCurve returns Vec3[float64]{0, math.Sqrt2, 0} at u = 0
+ */
+func (c NURBSCurve[T]) Derivative(u T) Vec3[T] {
+	return c.Derivatives(u, 1)[1]
+}
+
+/**
+ * Derivatives returns the point of the curve at u and its derivatives up to order n, with the k-th derivative at
+ * index k. The derivatives of the rational curve follow from those of its homogeneous form by the quotient rule.
+ * For example:
+ *   c.Derivatives(u, 2) returns []Vec3[T]{c.At(u), c.Derivative(u), the second derivative}
+ */
+func (c NURBSCurve[T]) Derivatives(u T, n int) []Vec3[T] {
+	x := Clamp(float64(u), c.knots[c.degree], c.knots[len(c.points)])
+	p := c.degree
+	span := findSpan(c.knots, p, len(c.points), x)
+	ders := basisDerivatives(c.knots, p, span, x, n)
+	a := make([]Vec4[float64], n+1)
+	for k := range a {
+		for j := 0; j <= p; j++ {
+			a[k] = a[k].Add(c.points[span-p+j].Scale(ders[k][j]))
+		}
+	}
+
+	ck := make([]Vec3[float64], n+1)
+	out := make([]Vec3[T], n+1)
+	for k := range ck {
+		v := Vec3[float64]{a[k].X, a[k].Y, a[k].Z}
+		for i := 1; i <= k; i++ {
+			v = v.Sub(ck[k-i].Scale(binomial(k, i) * a[i].W))
+		}
+		ck[k] = v.Scale(1 / a[0].W)
+		out[k] = vec3ToT[T](ck[k])
+	}
+	return out
+}
+
+/**
+ * InsertKnot returns the same curve with the knot u inserted times more times, which adds times control points
+ * without changing the shape. Repeated insertion is how a curve is split or converted to Bézier segments. A times of
+ * zero or less returns the curve unchanged. It returns ErrOutOfRange if u lies outside the domain and ErrKnotVector if
+ * u would be repeated more than degree times.
+ * For example:
+ *   inserting 0.5 once into the knots {0, 0, 0, 1, 1, 1} gives knots {0, 0, 0, 0.5, 1, 1, 1} and four control points
+ */
+func (c NURBSCurve[T]) InsertKnot(u T, times int) (NURBSCurve[T], error) {
+	x := float64(u)
+	if err := checkInsertion(c.degree, c.knots, len(c.points), x, times); err != nil || times <= 0 {
+		return c, err
+	}
+	c.knots, c.points = insertKnot(c.degree, c.knots, c.points, x, times)
+	return c, nil
+}
+
+/**
+ * NewNURBSSurface returns the NURBS surface with the given degrees, knot vectors, control net and weights. The net is
+ * indexed as points[i][j], with i along u and j along v, and every row must have the same length. A nil weights slice
+ * makes every weight one. The knot vectors must satisfy the conditions of NewNURBSCurve in each direction.
+ * It returns ErrDomain if a degree is below one or a weight is not positive, ErrLength if the net is ragged, too small
+ * or does not match the weights, and ErrKnotVector for an invalid knot vector.
+ * For example:
+ *   NewNURBSSurface(1, 1, []float64{0, 0, 1, 1}, []float64{0, 0, 1, 1}, [][]Vec3[float64]{{{0, 0, 0}, {0, 1, 0}}, {{1, 0, 0}, {1, 1, 0}}}, nil) returns the unit square
+ */
+func NewNURBSSurface[T Numeric](degreeU, degreeV int, knotsU, knotsV []T, points [][]Vec3[T], weights [][]T) (NURBSSurface[T], error) {
+	if weights != nil && len(weights) != len(points) {
+		return NURBSSurface[T]{}, ErrLength
+	}
+	net := make([][]Vec4[T], len(points))
+	for i, row := range points {
+		var w []T
+		if weights != nil {
+			if len(weights[i]) != len(row) {
+				return NURBSSurface[T]{}, ErrLength
+			}
+			w = weights[i]
+		}
+		net[i] = weighted(row, w)
+	}
+	return NewNURBSSurfaceHomogeneous(degreeU, degreeV, knotsU, knotsV, net)
+}
+
+/**
+ * NewNURBSSurfaceHomogeneous returns the NURBS surface with a control net already in homogeneous form
+ * {w·x, w·y, w·z, w}. It returns errors as NewNURBSSurface does.
+ * For example:
+ *   NewNURBSSurfaceHomogeneous(1, 1, []float64{0, 0, 1, 1}, []float64{0, 0, 1, 1}, net) returns a bilinear patch
+ */
+func NewNURBSSurfaceHomogeneous[T Numeric](degreeU, degreeV int, knotsU, knotsV []T, points [][]Vec4[T]) (NURBSSurface[T], error) {
+	if len(points) == 0 {
+		return NURBSSurface[T]{}, ErrLength
+	}
+	for _, row := range points {
+		if len(row) != len(points[0]) {
+			return NURBSSurface[T]{}, ErrLength
+		}
+	}
+	ku, err := checkKnotVector(degreeU, knotsU, len(points))
+	if err != nil {
+		return NURBSSurface[T]{}, err
+	}
+	kv, err := checkKnotVector(degreeV, knotsV, len(points[0]))
+	if err != nil {
+		return NURBSSurface[T]{}, err
+	}
+	net := make([][]Vec4[float64], len(points))
+	for i, row := range points {
+		if net[i], err = homogeneousToFloat64(row); err != nil {
+			return NURBSSurface[T]{}, err
+		}
+	}
+	return NURBSSurface[T]{degreeU: degreeU, degreeV: degreeV, knotsU: ku, knotsV: kv, points: net}, nil
+}
+
+/**
+ * Degrees returns the polynomial degrees of the surface in u and v.
+ * For example:
+ *   a bilinear patch returns (1, 1)
+ */
+func (s NURBSSurface[T]) Degrees() (int, int) {
+	return s.degreeU, s.degreeV
+}
+
+/**
+ * Knots returns copies of the knot vectors in u and v.
+ * For example:
+ *   a bilinear patch returns []float64{0, 0, 1, 1} twice
+ */
+func (s NURBSSurface[T]) Knots() ([]T, []T) {
+	return toT[T](s.knotsU), toT[T](s.knotsV)
+}
+
+/**
+ * ControlPoints returns a copy of the control net in homogeneous form {w·x, w·y, w·z, w}, indexed as points[i][j].
+ * For example:
+ *   a control point {1, 1, 0} with weight 0.5 is returned as Vec4[float64]{0.5, 0.5, 0, 0.5}
+ */
+func (s NURBSSurface[T]) ControlPoints() [][]Vec4[T] {
+	net := make([][]Vec4[T], len(s.points))
+	for i, row := range s.points {
+		net[i] = homogeneousToT[T](row)
+	}
+	return net
+}
+
+/**
+ * Domain returns the parameter ranges of the surface in u and v.
+ * For example:
+ *   a bilinear patch returns (0, 1, 0, 1)
+ */
+func (s NURBSSurface[T]) Domain() (uMin, uMax, vMin, vMax T) {
+	return T(s.knotsU[s.degreeU]), T(s.knotsU[len(s.points)]), T(s.knotsV[s.degreeV]), T(s.knotsV[len(s.points[0])])
+}
+
+/**
+ * At returns the point of the surface at (u, v), which is clamped to the domain.
+ * For example:
+ *   the unit square from NewNURBSSurface returns Vec3[float64]{0.25, 0.5, 0} at (0.25, 0.5)
+ */
+func (s NURBSSurface[T]) At(u, v T) Vec3[T] {
+	return s.Derivatives(u, v, 0)[0][0]
+}
+
+/**
+ * Normal returns the unit normal of the surface at (u, v), the normalized cross product of the partial derivatives
+ * in u and v. Where the surface is degenerate, such as at the pole of a sphere, it returns the zero vector.
+ * For example:
+ *   the unit square from NewNURBSSurface returns Vec3[float64]{0, 0, 1} everywhere
+ */
+func (s NURBSSurface[T]) Normal(u, v T) Vec3[T] {
+	d := s.derivatives(float64(u), float64(v), 1)
+	return vec3ToT[T](d[1][0].Cross(d[0][1]).Norm())
+}
+
+/**
+ * Derivatives returns the point of the surface at (u, v) and its partial derivatives of total order up to n. The
+ * entry [k][l] is the derivative taken k times in u and l times in v, for k+l <= n, so [0][0] is the point, [1][0]
+ * the tangent in u and [0][1] the tangent in v.
+ * For example:
+ *   s.Derivatives(u, v, 1) returns [][]Vec3[T]{{s.At(u, v), the v tangent}, {the u tangent}}
+ */
+func (s NURBSSurface[T]) Derivatives(u, v T, n int) [][]Vec3[T] {
+	d := s.derivatives(float64(u), float64(v), n)
+	out := make([][]Vec3[T], len(d))
+	for k, row := range d {
+		out[k] = make([]Vec3[T], len(row))
+		for l, p := range row {
+			out[k][l] = vec3ToT[T](p)
+		}
+	}
+	return out
+}
+
+/**
+ * InsertKnotU returns the same surface with the knot u inserted times more times into the u knot vector. It returns
+ * errors as NURBSCurve.InsertKnot does.
+ * For example:
+ *   inserting 0.5 once into a surface with four rows of control points gives five rows
+ */
+func (s NURBSSurface[T]) InsertKnotU(u T, times int) (NURBSSurface[T], error) {
+	x := float64(u)
+	if err := checkInsertion(s.degreeU, s.knotsU, len(s.points), x, times); err != nil || times <= 0 {
+		return s, err
+	}
+	// Each column of the net along u is a curve and is refined on its own.
+	rows := len(s.points) + times
+	net := make([][]Vec4[float64], rows)
+	for i := range net {
+		net[i] = make([]Vec4[float64], len(s.points[0]))
+	}
+	column := make([]Vec4[float64], len(s.points))
+	var knots []float64
+	for j := range s.points[0] {
+		for i, row := range s.points {
+			column[i] = row[j]
+		}
+		var refined []Vec4[float64]
+		knots, refined = insertKnot(s.degreeU, s.knotsU, column, x, times)
+		for i, p := range refined {
+			net[i][j] = p
+		}
+	}
+	s.knotsU, s.points = knots, net
+	return s, nil
+}
+
+/**
+ * InsertKnotV returns the same surface with the knot v inserted times more times into the v knot vector. It returns
+ * errors as NURBSCurve.InsertKnot does.
+ * For example:
+ *   inserting 0.5 once into a surface with four columns of control points gives five columns
+ */
+func (s NURBSSurface[T]) InsertKnotV(v T, times int) (NURBSSurface[T], error) {
+	x := float64(v)
+	if err := checkInsertion(s.degreeV, s.knotsV, len(s.points[0]), x, times); err != nil || times <= 0 {
+		return s, err
+	}
+	net := make([][]Vec4[float64], len(s.points))
+	var knots []float64
+	for i, row := range s.points {
+		knots, net[i] = insertKnot(s.degreeV, s.knotsV, row, x, times)
+	}
+	s.knotsV, s.points = knots, net
+	return s, nil
+}
+
+// derivatives returns the partial derivatives of the surface up to total order n (The NURBS Book, algorithms A3.6 and
+// A4.4). The derivatives of the homogeneous surface are found first and the weights are then divided out.
+func (s NURBSSurface[T]) derivatives(u, v float64, n int) [][]Vec3[float64] {
+	p, q := s.degreeU, s.degreeV
+	u = Clamp(u, s.knotsU[p], s.knotsU[len(s.points)])
+	v = Clamp(v, s.knotsV[q], s.knotsV[len(s.points[0])])
+	uspan := findSpan(s.knotsU, p, len(s.points), u)
+	vspan := findSpan(s.knotsV, q, len(s.points[0]), v)
+	nu := basisDerivatives(s.knotsU, p, uspan, u, n)
+	nv := basisDerivatives(s.knotsV, q, vspan, v, n)
+
+	a := make([][]Vec4[float64], n+1)
+	for k := range a {
+		a[k] = make([]Vec4[float64], n-k+1)
+		for l := range a[k] {
+			for r := 0; r <= p; r++ {
+				var sum Vec4[float64]
+				for c := 0; c <= q; c++ {
+					sum = sum.Add(s.points[uspan-p+r][vspan-q+c].Scale(nv[l][c]))
+				}
+				a[k][l] = a[k][l].Add(sum.Scale(nu[k][r]))
+			}
+		}
+	}
+
+	skl := make([][]Vec3[float64], n+1)
+	for k := range skl {
+		skl[k] = make([]Vec3[float64], n-k+1)
+		for l := range skl[k] {
+			d := Vec3[float64]{a[k][l].X, a[k][l].Y, a[k][l].Z}
+			for j := 1; j <= l; j++ {
+				d = d.Sub(skl[k][l-j].Scale(binomial(l, j) * a[0][j].W))
+			}
+			for i := 1; i <= k; i++ {
+				d = d.Sub(skl[k-i][l].Scale(binomial(k, i) * a[i][0].W))
+				var mixed Vec3[float64]
+				for j := 1; j <= l; j++ {
+					mixed = mixed.Add(skl[k-i][l-j].Scale(binomial(l, j) * a[i][j].W))
+				}
+				d = d.Sub(mixed.Scale(binomial(k, i)))
+			}
+			skl[k][l] = d.Scale(1 / a[0][0].W)
+		}
+	}
+	return skl
+}
+
+// checkKnotVector validates the degree and knot vector of a B-spline with count control points and converts the knots
+// to float64.
+func checkKnotVector[T Numeric](degree int, knots []T, count int) ([]float64, error) {
+	if degree < 1 {
+		return nil, ErrDomain
+	}
+	if count < degree+1 {
+		return nil, ErrLength
+	}
+	if len(knots) != count+degree+1 {
+		return nil, ErrKnotVector
+	}
+	ks := make([]float64, len(knots))
+	run := 0
+	for i, k := range knots {
+		ks[i] = float64(k)
+		switch {
+		case i > 0 && !(ks[i] >= ks[i-1]):
+			return nil, ErrKnotVector
+		case i > 0 && ks[i] == ks[i-1]:
+			run++
+		default:
+			run = 1
+		}
+		if run > degree+1 || math.IsNaN(ks[i]) {
+			return nil, ErrKnotVector
+		}
+	}
+	if !(ks[degree] < ks[count]) {
+		return nil, ErrKnotVector
+	}
+	return ks, nil
+}
+
+// checkInsertion validates inserting the knot u times times into a B-spline of the given degree.
+func checkInsertion(degree int, knots []float64, count int, u float64, times int) error {
+	if !(u >= knots[degree] && u <= knots[count]) {
+		return ErrOutOfRange
+	}
+	if multiplicity(knots, u)+times > degree {
+		return ErrKnotVector
+	}
+	return nil
+}
+
+// findSpan returns the index i of the non-empty knot span [knots[i], knots[i+1]) containing u, which must lie in the
+// domain. The end of the domain belongs to the last non-empty span.
+func findSpan(knots []float64, degree, count int, u float64) int {
+	i := sort.Search(len(knots), func(j int) bool { return knots[j] > u }) - 1
+	i = Clamp(i, degree, count-1)
+	for i > degree && knots[i] == knots[i+1] {
+		i--
+	}
+	return i
+}
+
+// multiplicity returns the number of times u appears in the knot vector.
+func multiplicity(knots []float64, u float64) int {
+	lo := sort.SearchFloat64s(knots, u)
+	hi := sort.Search(len(knots), func(j int) bool { return knots[j] > u })
+	return hi - lo
+}
+
+// basisDerivatives returns the degree+1 B-spline basis functions that are nonzero on the given span at u and their
+// derivatives up to order n, with ders[k][j] the k-th derivative of basis function span-degree+j (The NURBS Book,
+// algorithm A2.3). Derivatives of order above the degree are zero.
+func basisDerivatives(knots []float64, degree, span int, u float64, n int) [][]float64 {
+	p := degree
+	ndu := make([][]float64, p+1)
+	for j := range ndu {
+		ndu[j] = make([]float64, p+1)
+	}
+	left, right := make([]float64, p+1), make([]float64, p+1)
+	ndu[0][0] = 1
+	for j := 1; j <= p; j++ {
+		left[j] = u - knots[span+1-j]
+		right[j] = knots[span+j] - u
+		var saved float64
+		for r := 0; r < j; r++ {
+			// The lower triangle holds the knot differences and the upper triangle the basis functions.
+			ndu[j][r] = right[r+1] + left[j-r]
+			temp := ndu[r][j-1] / ndu[j][r]
+			ndu[r][j] = saved + right[r+1]*temp
+			saved = left[j-r] * temp
+		}
+		ndu[j][j] = saved
+	}
+
+	ders := make([][]float64, n+1)
+	for k := range ders {
+		ders[k] = make([]float64, p+1)
+	}
+	for j := 0; j <= p; j++ {
+		ders[0][j] = ndu[j][p]
+	}
+	a := [2][]float64{make([]float64, p+1), make([]float64, p+1)}
+	for r := 0; r <= p; r++ {
+		s1, s2 := 0, 1
+		a[0][0] = 1
+		for k := 1; k <= min(n, p); k++ {
+			var d float64
+			rk, pk := r-k, p-k
+			if r >= k {
+				a[s2][0] = a[s1][0] / ndu[pk+1][rk]
+				d = a[s2][0] * ndu[rk][pk]
+			}
+			j1, j2 := 1, k-1
+			if rk < -1 {
+				j1 = -rk
+			}
+			if r-1 > pk {
+				j2 = p - r
+			}
+			for j := j1; j <= j2; j++ {
+				a[s2][j] = (a[s1][j] - a[s1][j-1]) / ndu[pk+1][rk+j]
+				d += a[s2][j] * ndu[rk+j][pk]
+			}
+			if r <= pk {
+				a[s2][k] = -a[s1][k-1] / ndu[pk+1][r]
+				d += a[s2][k] * ndu[r][pk]
+			}
+			ders[k][r] = d
+			s1, s2 = s2, s1
+		}
+	}
+	factor := float64(p)
+	for k := 1; k <= min(n, p); k++ {
+		for j := range ders[k] {
+			ders[k][j] *= factor
+		}
+		factor *= float64(p - k)
+	}
+	return ders
+}
+
+// insertKnot inserts u times times into a B-spline with the given knots and homogeneous control points and returns the
+// new knots and control points (The NURBS Book, algorithm A5.1). The caller checks that the insertion is valid.
+func insertKnot(degree int, knots []float64, points []Vec4[float64], u float64, times int) ([]float64, []Vec4[float64]) {
+	p, r := degree, times
+	k := findSpan(knots, p, len(points), u)
+	s := multiplicity(knots, u)
+
+	uq := make([]float64, len(knots)+r)
+	copy(uq, knots[:k+1])
+	for i := 1; i <= r; i++ {
+		uq[k+i] = u
+	}
+	copy(uq[k+1+r:], knots[k+1:])
+
+	// Control points away from the inserted knot are unchanged. The p-s points it affects are blended r times.
+	q := make([]Vec4[float64], len(points)+r)
+	copy(q, points[:k-p+1])
+	copy(q[k-s+r:], points[k-s:])
+	tmp := make([]Vec4[float64], p-s+1)
+	copy(tmp, points[k-p:k-s+1])
+	var l int
+	for j := 1; j <= r; j++ {
+		l = k - p + j
+		for i := 0; i <= p-j-s; i++ {
+			alpha := (u - knots[l+i]) / (knots[i+k+1] - knots[l+i])
+			tmp[i] = tmp[i+1].Scale(alpha).Add(tmp[i].Scale(1 - alpha))
+		}
+		q[l] = tmp[0]
+		q[k+r-j-s] = tmp[p-j-s]
+	}
+	for i := l + 1; i < k-s; i++ {
+		q[i] = tmp[i-l]
+	}
+	return uq, q
+}
+
+// weighted returns the control points in homogeneous form. A nil weights slice weights every point by one.
+func weighted[T Numeric](points []Vec3[T], weights []T) []Vec4[T] {
+	h := make([]Vec4[T], len(points))
+	for i, p := range points {
+		var w T = 1
+		if weights != nil {
+			w = weights[i]
+		}
+		h[i] = Vec4[T]{X: p.X * w, Y: p.Y * w, Z: p.Z * w, W: w}
+	}
+	return h
+}
+
+// homogeneousToFloat64 converts homogeneous control points to float64, returning ErrDomain for a weight that is not
+// positive.
+func homogeneousToFloat64[T Numeric](points []Vec4[T]) ([]Vec4[float64], error) {
+	out := make([]Vec4[float64], len(points))
+	for i, p := range points {
+		out[i] = Vec4[float64]{X: float64(p.X), Y: float64(p.Y), Z: float64(p.Z), W: float64(p.W)}
+		if !(out[i].W > 0) {
+			return nil, ErrDomain
+		}
+	}
+	return out, nil
+}
+
+// homogeneousToT converts homogeneous control points back to T.
+func homogeneousToT[T Numeric](points []Vec4[float64]) []Vec4[T] {
+	out := make([]Vec4[T], len(points))
+	for i, p := range points {
+		out[i] = Vec4[T]{X: T(p.X), Y: T(p.Y), Z: T(p.Z), W: T(p.W)}
+	}
+	return out
+}
+
+// vec3ToT converts a float64 vector to T.
+func vec3ToT[T Numeric](v Vec3[float64]) Vec3[T] {
+	return Vec3[T]{X: T(v.X), Y: T(v.Y), Z: T(v.Z)}
+}
+
+// binomial returns the binomial coefficient n choose k as a float64.
+func binomial(n, k int) float64 {
+	b := 1.0
+	for i := 1; i <= k; i++ {
+		b = b * float64(n-k+i) / float64(i)
+	}
+	return b
+}
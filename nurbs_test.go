@@ -0,0 +1,237 @@
+package bm
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// unitCircle returns the full unit circle in the xy-plane as a quadratic NURBS curve of four quarter arcs.
+func unitCircle(t *testing.T) NURBSCurve[float64] {
+	t.Helper()
+	r := math.Sqrt2 / 2
+	c, err := NewNURBSCurve(2,
+		[]float64{0, 0, 0, 0.25, 0.25, 0.5, 0.5, 0.75, 0.75, 1, 1, 1},
+		[]Vec3[float64]{{1, 0, 0}, {1, 1, 0}, {0, 1, 0}, {-1, 1, 0}, {-1, 0, 0}, {-1, -1, 0}, {0, -1, 0}, {1, -1, 0}, {1, 0, 0}},
+		[]float64{1, r, 1, r, 1, r, 1, r, 1})
+	if err != nil {
+		t.Fatalf("NewNURBSCurve() error = %v", err)
+	}
+	return c
+}
+
+// TestNURBSCurveCircle tests that the rational curve is an exact circle and that its derivatives are consistent.
+func TestNURBSCurveCircle(t *testing.T) {
+	c := unitCircle(t)
+	if lo, hi := c.Domain(); lo != 0 || hi != 1 {
+		t.Errorf("Domain() = (%v, %v), want (0, 1)", lo, hi)
+	}
+	const h = 1e-6
+	for k := 0; k <= 40; k++ {
+		u := float64(k) / 40
+		d := c.Derivatives(u, 2)
+		if r := d[0].Mag(); !approxEqual(r, 1, 1e-14) {
+			t.Errorf("|At(%v)| = %v, want 1", u, r)
+		}
+		// The tangent is perpendicular to the radius and the acceleration has a component towards the centre.
+		if dot := d[0].Dot(d[1]); !approxEqual(dot, 0, 1e-12) {
+			t.Errorf("At(%v)·Derivative(%v) = %v, want 0", u, u, dot)
+		}
+		if k == 0 || k == 40 || k%10 == 0 {
+			continue
+		}
+		want := c.At(u + h).Sub(c.At(u - h)).Scale(1 / (2 * h))
+		if !vec3ApproxEqual(d[1], want, 1e-6) || !vec3ApproxEqual(c.Derivative(u), d[1], 1e-15) {
+			t.Errorf("Derivative(%v) = %v, want %v", u, d[1], want)
+		}
+		want = c.Derivative(u + h).Sub(c.Derivative(u - h)).Scale(1 / (2 * h))
+		if !vec3ApproxEqual(d[2], want, 1e-4) {
+			t.Errorf("second derivative at %v = %v, want %v", u, d[2], want)
+		}
+	}
+	if got := c.At(0.125); !vec3ApproxEqual(got, Vec3[float64]{math.Sqrt2 / 2, math.Sqrt2 / 2, 0}, 1e-15) {
+		t.Errorf("At(0.125) = %v, want {√2/2 √2/2 0}", got)
+	}
+	if got := c.Derivative(0); !vec3ApproxEqual(got, Vec3[float64]{0, 4 * math.Sqrt2, 0}, 1e-12) {
+		t.Errorf("Derivative(0) = %v, want {0 4√2 0}", got)
+	}
+	if got, want := c.At(-1), c.At(0); got != want {
+		t.Errorf("At(-1) = %v, want the clamped %v", got, want)
+	}
+}
+
+// TestNURBSCurveInsertKnot tests that knot insertion leaves the curve unchanged.
+func TestNURBSCurveInsertKnot(t *testing.T) {
+	c, err := NewNURBSCurve(3,
+		[]float64{0, 0, 0, 0, 0.3, 0.5, 0.5, 1, 1, 1, 1},
+		[]Vec3[float64]{{0, 0, 0}, {1, 2, 0}, {2, 2, 1}, {3, 0, 1}, {4, -1, 0}, {5, 1, 2}, {6, 0, 0}},
+		[]float64{1, 0.5, 2, 1, 1.5, 0.7, 1})
+	if err != nil {
+		t.Fatalf("NewNURBSCurve() error = %v", err)
+	}
+	tests := []struct {
+		u     float64
+		times int
+	}{
+		{0.2, 1}, {0.2, 3}, {0.3, 2}, {0.5, 1}, {0.75, 2},
+	}
+	for _, tt := range tests {
+		refined, err := c.InsertKnot(tt.u, tt.times)
+		if err != nil {
+			t.Fatalf("InsertKnot(%v, %d) error = %v", tt.u, tt.times, err)
+		}
+		if got, want := len(refined.ControlPoints()), 7+tt.times; got != want {
+			t.Errorf("InsertKnot(%v, %d) has %d control points, want %d", tt.u, tt.times, got, want)
+		}
+		if got := multiplicity(refined.knots, tt.u) - multiplicity(c.knots, tt.u); got != tt.times {
+			t.Errorf("InsertKnot(%v, %d) raised the multiplicity by %d", tt.u, tt.times, got)
+		}
+		for k := 0; k <= 50; k++ {
+			u := float64(k) / 50
+			if got, want := refined.At(u), c.At(u); !vec3ApproxEqual(got, want, 1e-12) {
+				t.Errorf("InsertKnot(%v, %d).At(%v) = %v, want %v", tt.u, tt.times, u, got, want)
+			}
+		}
+	}
+
+	// Inserting until the multiplicity equals the degree interpolates the curve at that knot.
+	split, _ := c.InsertKnot(0.3, 2)
+	if got, want := split.ControlPoints()[3], c.At(0.3); !vec3ApproxEqual(Vec3[float64]{got.X, got.Y, got.Z}.Scale(1/got.W), want, 1e-12) {
+		t.Errorf("control point at the split = %v, want %v", got, want)
+	}
+	if _, err := c.InsertKnot(0.5, 2); !errors.Is(err, ErrKnotVector) {
+		t.Errorf("InsertKnot(0.5, 2) error = %v, want %v", err, ErrKnotVector)
+	}
+	if _, err := c.InsertKnot(1.5, 1); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("InsertKnot(1.5, 1) error = %v, want %v", err, ErrOutOfRange)
+	}
+}
+
+// TestNURBSCurveErrors tests that invalid definitions are rejected.
+func TestNURBSCurveErrors(t *testing.T) {
+	points := []Vec3[float64]{{0, 0, 0}, {1, 1, 0}, {2, 0, 0}}
+	tests := []struct {
+		name    string
+		degree  int
+		knots   []float64
+		weights []float64
+		err     error
+	}{
+		{"degree zero", 0, []float64{0, 0.5, 1, 1}, nil, ErrDomain},
+		{"too few points", 3, []float64{0, 0, 0, 0, 1, 1, 1}, nil, ErrLength},
+		{"weights mismatch", 2, []float64{0, 0, 0, 1, 1, 1}, []float64{1, 1}, ErrLength},
+		{"negative weight", 2, []float64{0, 0, 0, 1, 1, 1}, []float64{1, -1, 1}, ErrDomain},
+		{"knot count", 2, []float64{0, 0, 0, 1, 1}, nil, ErrKnotVector},
+		{"decreasing", 2, []float64{0, 0, 0, 1, 0.5, 1}, nil, ErrKnotVector},
+		{"empty domain", 1, []float64{0, 1, 1, 1, 2}, nil, ErrKnotVector},
+		{"NaN knot", 2, []float64{0, 0, 0, math.NaN(), 1, 1}, nil, ErrKnotVector},
+	}
+	for _, tt := range tests {
+		if _, err := NewNURBSCurve(tt.degree, tt.knots, points, tt.weights); !errors.Is(err, tt.err) {
+			t.Errorf("%s: NewNURBSCurve() error = %v, want %v", tt.name, err, tt.err)
+		}
+	}
+}
+
+// TestNURBSSurfaceCylinder tests a quarter cylinder of radius 2, which is exact in u and linear in v.
+func TestNURBSSurfaceCylinder(t *testing.T) {
+	r := math.Sqrt2 / 2
+	s, err := NewNURBSSurface(2, 1,
+		[]float64{0, 0, 0, 1, 1, 1}, []float64{0, 0, 3, 3},
+		[][]Vec3[float64]{
+			{{2, 0, 0}, {2, 0, 3}},
+			{{2, 2, 0}, {2, 2, 3}},
+			{{0, 2, 0}, {0, 2, 3}},
+		},
+		[][]float64{{1, 1}, {r, r}, {1, 1}})
+	if err != nil {
+		t.Fatalf("NewNURBSSurface() error = %v", err)
+	}
+	if p, q := s.Degrees(); p != 2 || q != 1 {
+		t.Errorf("Degrees() = (%d, %d), want (2, 1)", p, q)
+	}
+	if u0, u1, v0, v1 := s.Domain(); u0 != 0 || u1 != 1 || v0 != 0 || v1 != 3 {
+		t.Errorf("Domain() = (%v, %v, %v, %v), want (0, 1, 0, 3)", u0, u1, v0, v1)
+	}
+	const h = 1e-6
+	for _, u := range []float64{0, 0.2, 0.5, 0.9, 1} {
+		for _, v := range []float64{0, 1.2, 3} {
+			p := s.At(u, v)
+			if radius := math.Hypot(p.X, p.Y); !approxEqual(radius, 2, 1e-14) || !approxEqual(p.Z, v, 1e-14) {
+				t.Errorf("At(%v, %v) = %v, want a point at radius 2 and height %v", u, v, p, v)
+			}
+			want := Vec3[float64]{p.X / 2, p.Y / 2, 0}
+			if got := s.Normal(u, v); !vec3ApproxEqual(got, want, 1e-12) {
+				t.Errorf("Normal(%v, %v) = %v, want %v", u, v, got, want)
+			}
+		}
+	}
+
+	d := s.Derivatives(0.3, 1.5, 2)
+	if len(d) != 3 || len(d[0]) != 3 || len(d[1]) != 2 || len(d[2]) != 1 {
+		t.Fatalf("Derivatives() shape = %d rows, want a triangle of 3, 2 and 1", len(d))
+	}
+	su := s.At(0.3+h, 1.5).Sub(s.At(0.3-h, 1.5)).Scale(1 / (2 * h))
+	sv := s.At(0.3, 1.5+h).Sub(s.At(0.3, 1.5-h)).Scale(1 / (2 * h))
+	if !vec3ApproxEqual(d[1][0], su, 1e-6) || !vec3ApproxEqual(d[0][1], sv, 1e-6) {
+		t.Errorf("Derivatives() tangents = (%v, %v), want (%v, %v)", d[1][0], d[0][1], su, sv)
+	}
+	if !vec3ApproxEqual(d[0][2], Vec3[float64]{}, 1e-12) || !vec3ApproxEqual(d[1][1], Vec3[float64]{}, 1e-12) {
+		t.Errorf("Derivatives() = %v and %v along the straight rulings, want zero", d[0][2], d[1][1])
+	}
+	suu := s.Derivatives(0.3+h, 1.5, 1)[1][0].Sub(s.Derivatives(0.3-h, 1.5, 1)[1][0]).Scale(1 / (2 * h))
+	if !vec3ApproxEqual(d[2][0], suu, 1e-4) {
+		t.Errorf("Derivatives()[2][0] = %v, want %v", d[2][0], suu)
+	}
+}
+
+// TestNURBSSurfaceInsertKnot tests that knot insertion in either direction leaves the surface unchanged.
+func TestNURBSSurfaceInsertKnot(t *testing.T) {
+	net := [][]Vec3[float64]{
+		{{0, 0, 0}, {0, 1, 1}, {0, 2, 0}, {0, 3, 1}},
+		{{1, 0, 1}, {1, 1, 2}, {1, 2, 1}, {1, 3, 0}},
+		{{2, 0, 0}, {2, 1, -1}, {2, 2, 2}, {2, 3, 1}},
+	}
+	weights := [][]float64{{1, 2, 1, 1}, {0.5, 1, 1, 3}, {1, 1, 0.8, 1}}
+	s, err := NewNURBSSurface(2, 2, []float64{0, 0, 0, 1, 1, 1}, []float64{0, 0, 0, 0.4, 1, 1, 1}, net, weights)
+	if err != nil {
+		t.Fatalf("NewNURBSSurface() error = %v", err)
+	}
+	su, err := s.InsertKnotU(0.6, 2)
+	if err != nil {
+		t.Fatalf("InsertKnotU() error = %v", err)
+	}
+	sv, err := s.InsertKnotV(0.4, 1)
+	if err != nil {
+		t.Fatalf("InsertKnotV() error = %v", err)
+	}
+	if got := su.ControlPoints(); len(got) != 5 || len(got[0]) != 4 {
+		t.Errorf("InsertKnotU() net is %dx%d, want 5x4", len(got), len(got[0]))
+	}
+	if got := sv.ControlPoints(); len(got) != 3 || len(got[0]) != 5 {
+		t.Errorf("InsertKnotV() net is %dx%d, want 3x5", len(got), len(got[0]))
+	}
+	if ku, kv := sv.Knots(); len(ku) != 6 || len(kv) != 8 {
+		t.Errorf("InsertKnotV() knots have lengths (%d, %d), want (6, 8)", len(ku), len(kv))
+	}
+	for _, u := range []float64{0, 0.25, 0.6, 0.9, 1} {
+		for _, v := range []float64{0, 0.1, 0.4, 0.7, 1} {
+			want := s.At(u, v)
+			if got := su.At(u, v); !vec3ApproxEqual(got, want, 1e-12) {
+				t.Errorf("InsertKnotU().At(%v, %v) = %v, want %v", u, v, got, want)
+			}
+			if got := sv.At(u, v); !vec3ApproxEqual(got, want, 1e-12) {
+				t.Errorf("InsertKnotV().At(%v, %v) = %v, want %v", u, v, got, want)
+			}
+			if got, want := sv.Normal(u, v), s.Normal(u, v); !vec3ApproxEqual(got, want, 1e-9) {
+				t.Errorf("InsertKnotV().Normal(%v, %v) = %v, want %v", u, v, got, want)
+			}
+		}
+	}
+	if _, err := s.InsertKnotV(0.4, 2); !errors.Is(err, ErrKnotVector) {
+		t.Errorf("InsertKnotV(0.4, 2) error = %v, want %v", err, ErrKnotVector)
+	}
+	if _, err := NewNURBSSurface(2, 2, []float64{0, 0, 0, 1, 1, 1}, []float64{0, 0, 0, 0.4, 1, 1, 1}, [][]Vec3[float64]{net[0], net[1][:3], net[2]}, nil); !errors.Is(err, ErrLength) {
+		t.Errorf("ragged net error = %v, want %v", err, ErrLength)
+	}
+}
@@ -0,0 +1,171 @@
+package bm
+
+// obbEpsilon is added to the rotation terms of the separating axis test to stay robust for near-parallel edges.
+const obbEpsilon = 1e-10
+
+// OBB represents an oriented bounding box. The columns of Axes are the box's unit local axes in world space,
+// and HalfExtents holds the half-size of the box along each of them.
+type OBB[T Numeric] struct {
+	Center      Vec3[T]
+	Axes        Mat3[T]
+	HalfExtents Vec3[T]
+}
+
+/**
+ * NewOBB returns the oriented box with the given center, rotation matrix and half-extents.
+ * For example:
+ *   NewOBB(Vec3[float64]{0, 0, 0}, RotateZ(math.Pi/4), Vec3[float64]{1, 1, 1}) returns a unit cube rotated around Z
+ */
+func NewOBB[T Numeric](center Vec3[T], axes Mat3[T], halfExtents Vec3[T]) OBB[T] {
+	return OBB[T]{Center: center, Axes: axes, HalfExtents: halfExtents}
+}
+
+/**
+ * OBBFromAABB3 returns the oriented box covering the same space as the axis-aligned box b.
+ * For example:
+ *   OBBFromAABB3(AABB3[float64]{Max: {2, 2, 2}}) returns NewOBB(Vec3[float64]{1, 1, 1}, IdentityMat3[float64](), Vec3[float64]{1, 1, 1})
+ */
+func OBBFromAABB3[T Numeric](b AABB3[T]) OBB[T] {
+	return OBB[T]{Center: b.Center(), Axes: IdentityMat3[T](), HalfExtents: b.Extents()}
+}
+
+/**
+ * Axis returns the i-th local axis of the box in world space.
+ * For example:
+ *   NewOBB(Vec3[float64]{}, RotateZ(math.Pi/2), Vec3[float64]{1, 1, 1}).Axis(0) returns approximately Vec3[float64]{0, 1, 0}
+ */
+func (o OBB[T]) Axis(i int) Vec3[T] {
+	return Vec3[T]{X: o.Axes[0][i], Y: o.Axes[1][i], Z: o.Axes[2][i]}
+}
+
+/**
+ * ToLocal returns the point p expressed in the box's local frame, with the box center at the origin.
+ * For example:
+ *   NewOBB(Vec3[float64]{1, 0, 0}, IdentityMat3[float64](), Vec3[float64]{1, 1, 1}).ToLocal(Vec3[float64]{2, 0, 0}) returns Vec3[float64]{1, 0, 0}
+ */
+func (o OBB[T]) ToLocal(p Vec3[T]) Vec3[T] {
+	return o.Axes.VecMul(p.Sub(o.Center))
+}
+
+/**
+ * Contains reports whether the point p lies inside or on the box.
+ * For example:
+ *   NewOBB(Vec3[float64]{}, RotateZ(math.Pi/4), Vec3[float64]{1, 1, 1}).Contains(Vec3[float64]{1.4, 0, 0}) returns true
+ */
+func (o OBB[T]) Contains(p Vec3[T]) bool {
+	l := o.ToLocal(p)
+	return Abs(l.X) <= o.HalfExtents.X && Abs(l.Y) <= o.HalfExtents.Y && Abs(l.Z) <= o.HalfExtents.Z
+}
+
+/**
+ * ClosestPoint returns the point inside or on the box closest to p.
+ * For example:
+ *   NewOBB(Vec3[float64]{}, IdentityMat3[float64](), Vec3[float64]{1, 1, 1}).ClosestPoint(Vec3[float64]{3, 0, 0}) returns Vec3[float64]{1, 0, 0}
+ */
+func (o OBB[T]) ClosestPoint(p Vec3[T]) Vec3[T] {
+	l := o.ToLocal(p)
+	h := o.HalfExtents
+	l = Vec3[T]{X: Clamp(l.X, -h.X, h.X), Y: Clamp(l.Y, -h.Y, h.Y), Z: Clamp(l.Z, -h.Z, h.Z)}
+	return o.Center.Add(o.Axes.MulVec(l))
+}
+
+/**
+ * Distance returns the distance from p to the box, or 0 if p is inside.
+ * For example:
+ *   NewOBB(Vec3[float64]{}, IdentityMat3[float64](), Vec3[float64]{1, 1, 1}).Distance(Vec3[float64]{3, 0, 0}) returns 2
+ */
+func (o OBB[T]) Distance(p Vec3[T]) T {
+	return p.Dist(o.ClosestPoint(p))
+}
+
+/**
+ * Corners returns the eight corner points of the box in world space.
+ * For example:
+ *   NewOBB(Vec3[float64]{}, IdentityMat3[float64](), Vec3[float64]{1, 1, 1}).Corners()[7] returns Vec3[float64]{1, 1, 1}
+ */
+func (o OBB[T]) Corners() [8]Vec3[T] {
+	corners := AABB3[T]{Min: o.HalfExtents.Neg(), Max: o.HalfExtents}.Corners()
+	for i := range corners {
+		corners[i] = o.Center.Add(o.Axes.MulVec(corners[i]))
+	}
+	return corners
+}
+
+/**
+ * Bounds returns the axis-aligned box enclosing the oriented box.
+ * For example:
+ *   NewOBB(Vec3[float64]{}, RotateZ(math.Pi/4), Vec3[float64]{1, 1, 1}).Bounds() returns AABB3[float64]{Min: {-1.4142, -1.4142, -1}, Max: {1.4142, 1.4142, 1}}
+ */
+func (o OBB[T]) Bounds() AABB3[T] {
+	corners := o.Corners()
+	return AABB3FromPoints(corners[:]...)
+}
+
+/**
+ * Overlaps reports whether the two oriented boxes intersect or touch, using the separating axis test.
+ * For example:
+ *   NewOBB(Vec3[float64]{}, IdentityMat3[float64](), Vec3[float64]{1, 1, 1}).Overlaps(NewOBB(Vec3[float64]{2.3, 0, 0}, RotateZ(math.Pi/4), Vec3[float64]{1, 1, 1}))
+ *   returns true
+ */
+func (o OBB[T]) Overlaps(other OBB[T]) bool {
+	var eps float64 = obbEpsilon
+	ea := [3]T{o.HalfExtents.X, o.HalfExtents.Y, o.HalfExtents.Z}
+	eb := [3]T{other.HalfExtents.X, other.HalfExtents.Y, other.HalfExtents.Z}
+
+	// Rotation expressing other in o's frame.
+	r := o.Axes.Transpose().Mul(other.Axes)
+	var absR Mat3[T]
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			absR[i][j] = Abs(r[i][j]) + T(eps)
+		}
+	}
+	tv := o.ToLocal(other.Center)
+	t := [3]T{tv.X, tv.Y, tv.Z}
+
+	for i := 0; i < 3; i++ {
+		rb := eb[0]*absR[i][0] + eb[1]*absR[i][1] + eb[2]*absR[i][2]
+		if Abs(t[i]) > ea[i]+rb {
+			return false
+		}
+	}
+	for j := 0; j < 3; j++ {
+		ra := ea[0]*absR[0][j] + ea[1]*absR[1][j] + ea[2]*absR[2][j]
+		if Abs(t[0]*r[0][j]+t[1]*r[1][j]+t[2]*r[2][j]) > ra+eb[j] {
+			return false
+		}
+	}
+	for i := 0; i < 3; i++ {
+		i1, i2 := (i+1)%3, (i+2)%3
+		for j := 0; j < 3; j++ {
+			j1, j2 := (j+1)%3, (j+2)%3
+			ra := ea[i1]*absR[i2][j] + ea[i2]*absR[i1][j]
+			rb := eb[j1]*absR[i][j2] + eb[j2]*absR[i][j1]
+			if Abs(t[i2]*r[i1][j]-t[i1]*r[i2][j]) > ra+rb {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+/**
+ * OverlapsSphere reports whether the oriented box intersects or touches the sphere.
+ * For example:
+ *   NewOBB(Vec3[float64]{}, RotateZ(math.Pi/4), Vec3[float64]{1, 1, 1}).OverlapsSphere(NewSphere(Vec3[float64]{2, 0, 0}, 0.5)) returns true
+ */
+func (o OBB[T]) OverlapsSphere(s Sphere[T]) bool {
+	return s.OverlapsOBB(o)
+}
+
+/**
+ * Support returns the corner of the box farthest along dir, so an OBB can be used with GJK and EPA.
+ * For example:
+ *   NewOBB(Vec3[float64]{}, IdentityMat3[float64](), Vec3[float64]{1, 2, 3}).Support(Vec3[float64]{1, -1, 1}) returns Vec3[float64]{1, -2, 3}
+ */
+func (o OBB[T]) Support(dir Vec3[T]) Vec3[T] {
+	l := o.Axes.VecMul(dir)
+	h := o.HalfExtents
+	corner := Vec3[T]{X: Copysign(h.X, l.X), Y: Copysign(h.Y, l.Y), Z: Copysign(h.Z, l.Z)}
+	return o.Center.Add(o.Axes.MulVec(corner))
+}
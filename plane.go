@@ -0,0 +1,77 @@
+package bm
+
+// Plane represents the infinite plane of points p satisfying Normal.Dot(p) + D == 0.
+type Plane[T Numeric] struct {
+	Normal Vec3[T]
+	D      T
+}
+
+/**
+ * NewPlane returns the plane with the given normal and offset d, so that points p on the plane satisfy normal.Dot(p) + d == 0.
+ * For example:
+ *   NewPlane(Vec3[float64]{0, 1, 0}, -2) returns the horizontal plane at height 2
+ */
+func NewPlane[T Numeric](normal Vec3[T], d T) Plane[T] {
+	return Plane[T]{Normal: normal, D: d}
+}
+
+/**
+ * PlaneFromNormalPoint returns the plane through point with the given normal. The normal is normalized.
+ * For example:
+ *   PlaneFromNormalPoint(Vec3[float64]{0, 2, 0}, Vec3[float64]{5, 3, 1}) returns Plane[float64]{Normal: {0, 1, 0}, D: -3}
+ */
+func PlaneFromNormalPoint[T Numeric](normal, point Vec3[T]) Plane[T] {
+	normal = normal.Norm()
+	return Plane[T]{Normal: normal, D: -normal.Dot(point)}
+}
+
+/**
+ * PlaneFromPoints returns the plane through the three points a, b and c. The normal points towards the side from which
+ * a, b, c appear counter-clockwise. If the points are collinear, the normal is zero.
+ * For example:
+ *   PlaneFromPoints(Vec3[float64]{0, 0, 1}, Vec3[float64]{1, 0, 1}, Vec3[float64]{0, 1, 1}) returns Plane[float64]{Normal: {0, 0, 1}, D: -1}
+ */
+func PlaneFromPoints[T Numeric](a, b, c Vec3[T]) Plane[T] {
+	return PlaneFromNormalPoint(b.Sub(a).Cross(c.Sub(a)), a)
+}
+
+/**
+ * Norm returns the same plane with a unit-length normal. A plane with a zero normal is returned unchanged.
+ * For example:
+ *   Plane[float64]{Normal: {0, 2, 0}, D: -4}.Norm() returns Plane[float64]{Normal: {0, 1, 0}, D: -2}
+ */
+func (p Plane[T]) Norm() Plane[T] {
+	mag := p.Normal.Mag()
+	if mag == 0 {
+		return p
+	}
+	return Plane[T]{Normal: p.Normal.Scale(1 / mag), D: p.D / mag}
+}
+
+/**
+ * SignedDistance returns the distance from the plane to point, positive on the side the normal points to.
+ * The plane's normal must have unit length.
+ * For example:
+ *   Plane[float64]{Normal: {0, 1, 0}, D: -2}.SignedDistance(Vec3[float64]{0, 5, 0}) returns 3
+ */
+func (p Plane[T]) SignedDistance(point Vec3[T]) T {
+	return p.Normal.Dot(point) + p.D
+}
+
+/**
+ * ProjectPoint returns the point on the plane closest to point. The plane's normal must have unit length.
+ * For example:
+ *   Plane[float64]{Normal: {0, 1, 0}, D: -2}.ProjectPoint(Vec3[float64]{1, 5, 1}) returns Vec3[float64]{1, 2, 1}
+ */
+func (p Plane[T]) ProjectPoint(point Vec3[T]) Vec3[T] {
+	return point.Sub(p.Normal.Scale(p.SignedDistance(point)))
+}
+
+/**
+ * Flip returns the plane facing the opposite direction.
+ * For example:
+ *   Plane[float64]{Normal: {0, 1, 0}, D: -2}.Flip() returns Plane[float64]{Normal: {0, -1, 0}, D: 2}
+ */
+func (p Plane[T]) Flip() Plane[T] {
+	return Plane[T]{Normal: p.Normal.Neg(), D: -p.D}
+}
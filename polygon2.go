@@ -0,0 +1,339 @@
+package bm
+
+// satTolerance is the separation difference below which the first polygon's face is preferred as the reference face,
+// which keeps contact manifolds stable from frame to frame.
+const satTolerance = 1e-6
+
+// Polygon2 is a convex polygon whose vertices are in counter-clockwise order.
+type Polygon2[T Numeric] []Vec2[T]
+
+// ManifoldPoint2 is a single contact point of a Manifold2 with its own penetration depth.
+type ManifoldPoint2[T Numeric] struct {
+	Point Vec2[T]
+	Depth T
+}
+
+// Manifold2 describes the contact between two overlapping 2D shapes A and B. Normal is the unit direction from A
+// towards B and moving B by Normal.Scale(Depth) separates the shapes. The first Count entries of Points lie on the
+// boundary of the shape that penetrates the other.
+type Manifold2[T Numeric] struct {
+	Normal Vec2[T]
+	Depth  T
+	Points [2]ManifoldPoint2[T]
+	Count  int
+}
+
+/**
+ * MTV returns the minimum translation vector, which moves B out of A.
+ * For example:
+ *   Manifold2[float64]{Normal: {1, 0}, Depth: 0.5}.MTV() returns Vec2[float64]{0.5, 0}
+ */
+func (m Manifold2[T]) MTV() Vec2[T] {
+	return m.Normal.Scale(m.Depth)
+}
+
+/**
+ * NewPolygon2 returns the convex polygon with the given vertices, reversing them if they are in clockwise order.
+ * The vertices are copied.
+ * For example:
+ *   NewPolygon2(Vec2[float64]{0, 0}, Vec2[float64]{0, 1}, Vec2[float64]{1, 0}) returns Polygon2[float64]{{1, 0}, {0, 1}, {0, 0}}
+ */
+func NewPolygon2[T Numeric](vertices ...Vec2[T]) Polygon2[T] {
+	p := make(Polygon2[T], len(vertices))
+	copy(p, vertices)
+	if p.signedArea() < 0 {
+		p.reverse()
+	}
+	return p
+}
+
+/**
+ * Polygon2FromAABB2 returns the box as a four-sided polygon.
+ * For example:
+ *   Polygon2FromAABB2(AABB2[float64]{Max: {1, 1}}) returns Polygon2[float64]{{0, 0}, {1, 0}, {1, 1}, {0, 1}}
+ */
+func Polygon2FromAABB2[T Numeric](b AABB2[T]) Polygon2[T] {
+	return Polygon2[T]{b.Min, {X: b.Max.X, Y: b.Min.Y}, b.Max, {X: b.Min.X, Y: b.Max.Y}}
+}
+
+/**
+ * Area returns the area of the polygon.
+ * For example:
+ *   Polygon2[float64]{{0, 0}, {2, 0}, {0, 2}}.Area() returns 2
+ */
+func (p Polygon2[T]) Area() T {
+	return Abs(p.signedArea())
+}
+
+/**
+ * Centroid returns the center of mass of the polygon.
+ * For example:
+ *   Polygon2[float64]{{0, 0}, {2, 0}, {2, 2}, {0, 2}}.Centroid() returns Vec2[float64]{1, 1}
+ */
+func (p Polygon2[T]) Centroid() Vec2[T] {
+	var c Vec2[T]
+	var area2 T
+	for i, a := range p {
+		b := p[(i+1)%len(p)]
+		cross := a.X*b.Y - a.Y*b.X
+		area2 += cross
+		c = c.Add(a.Add(b).Scale(cross))
+	}
+	if area2 == 0 {
+		return c
+	}
+	return Vec2[T]{X: c.X / (3 * area2), Y: c.Y / (3 * area2)}
+}
+
+/**
+ * EdgeNormal returns the outward unit normal of the edge from vertex i to vertex i+1.
+ * For example:
+ *   Polygon2[float64]{{0, 0}, {1, 0}, {1, 1}, {0, 1}}.EdgeNormal(0) returns Vec2[float64]{0, -1}
+ */
+func (p Polygon2[T]) EdgeNormal(i int) Vec2[T] {
+	e := p[(i+1)%len(p)].Sub(p[i])
+	return Vec2[T]{X: e.Y, Y: -e.X}.Norm()
+}
+
+/**
+ * Contains reports whether the point lies inside or on the polygon.
+ * For example:
+ *   Polygon2[float64]{{0, 0}, {1, 0}, {0, 1}}.Contains(Vec2[float64]{0.25, 0.25}) returns true
+ */
+func (p Polygon2[T]) Contains(point Vec2[T]) bool {
+	for i, a := range p {
+		e := p[(i+1)%len(p)].Sub(a)
+		d := point.Sub(a)
+		if e.X*d.Y-e.Y*d.X < 0 {
+			return false
+		}
+	}
+	return len(p) > 0
+}
+
+/**
+ * Bounds returns the smallest axis-aligned box enclosing the polygon.
+ * For example:
+ *   Polygon2[float64]{{0, 0}, {2, 0}, {0, 1}}.Bounds() returns AABB2[float64]{Max: {2, 1}}
+ */
+func (p Polygon2[T]) Bounds() AABB2[T] {
+	return AABB2FromPoints(p...)
+}
+
+/**
+ * Transform returns the polygon transformed by the 2D affine matrix m, keeping the vertices counter-clockwise.
+ * For example:
+ *   Polygon2[float64]{{0, 0}, {1, 0}, {0, 1}}.Transform(Translate2D(Vec2[float64]{1, 0})) returns Polygon2[float64]{{1, 0}, {2, 0}, {1, 1}}
+ */
+func (p Polygon2[T]) Transform(m Mat3[T]) Polygon2[T] {
+	out := make(Polygon2[T], len(p))
+	for i, v := range p {
+		out[i] = m.TransformPoint2D(v)
+	}
+	if m[0][0]*m[1][1]-m[0][1]*m[1][0] < 0 {
+		out.reverse()
+	}
+	return out
+}
+
+/**
+ * Support returns the vertex of the polygon farthest along dir, so a Polygon2 can be used with GJK and EPA.
+ * For example:
+ *   Polygon2[float64]{{0, 0}, {1, 0}, {0, 1}}.Support(Vec2[float64]{0, 1}) returns Vec2[float64]{0, 1}
+ */
+func (p Polygon2[T]) Support(dir Vec2[T]) Vec2[T] {
+	return ConvexHull2[T](p).Support(dir)
+}
+
+/**
+ * Overlaps reports whether the two polygons intersect or touch, using the separating axis theorem.
+ * For example:
+ *   Polygon2FromAABB2(AABB2[float64]{Max: {1, 1}}).Overlaps(Polygon2FromAABB2(AABB2[float64]{Min: {0.5, 0.5}, Max: {2, 2}})) returns true
+ */
+func (p Polygon2[T]) Overlaps(other Polygon2[T]) bool {
+	sepA, _ := p.maxSeparation(other)
+	if sepA > 0 {
+		return false
+	}
+	sepB, _ := other.maxSeparation(p)
+	return sepB <= 0
+}
+
+/**
+ * MTV returns the minimum translation vector that moves other out of p, found with the separating axis theorem,
+ * and a boolean indicating whether the polygons overlap at all.
+ * For example:
+ *   Polygon2FromAABB2(AABB2[float64]{Max: {1, 1}}).MTV(Polygon2FromAABB2(AABB2[float64]{Min: {0.75, 0}, Max: {2, 1}}))
+ *   returns (Vec2[float64]{0.25, 0}, true)
+ */
+func (p Polygon2[T]) MTV(other Polygon2[T]) (Vec2[T], bool) {
+	m, ok := p.Collide(other)
+	if !ok {
+		return Vec2[T]{}, false
+	}
+	return m.MTV(), true
+}
+
+/**
+ * Collide returns the contact manifold between the two polygons and a boolean indicating whether they overlap.
+ * The axis of least penetration selects a reference face, and the most anti-parallel face of the other polygon is
+ * clipped against its side planes, giving up to two contact points.
+ * For example:
+ *   Polygon2FromAABB2(AABB2[float64]{Max: {1, 1}}).Collide(Polygon2FromAABB2(AABB2[float64]{Min: {0.75, 0}, Max: {2, 1}}))
+ *   returns a manifold with Normal {1, 0}, Depth 0.25 and the points {0.75, 1} and {0.75, 0}
+ */
+func (p Polygon2[T]) Collide(other Polygon2[T]) (Manifold2[T], bool) {
+	if len(p) < 3 || len(other) < 3 {
+		return Manifold2[T]{}, false
+	}
+	sepA, edgeA := p.maxSeparation(other)
+	if sepA > 0 {
+		return Manifold2[T]{}, false
+	}
+	sepB, edgeB := other.maxSeparation(p)
+	if sepB > 0 {
+		return Manifold2[T]{}, false
+	}
+
+	var eps float64 = satTolerance
+	tol := T(eps)
+	ref, inc, edge, sep, flip := p, other, edgeA, sepA, false
+	if sepB > sepA+tol {
+		ref, inc, edge, sep, flip = other, p, edgeB, sepB, true
+	}
+
+	n := ref.EdgeNormal(edge)
+	v1, v2 := ref[edge], ref[(edge+1)%len(ref)]
+
+	// The incident edge is the edge of the other polygon whose normal points most against the reference normal.
+	incEdge := 0
+	for i := range inc {
+		if inc.EdgeNormal(i).Dot(n) < inc.EdgeNormal(incEdge).Dot(n) {
+			incEdge = i
+		}
+	}
+	clip := [2]Vec2[T]{inc[incEdge], inc[(incEdge+1)%len(inc)]}
+
+	tangent := v2.Sub(v1).Norm()
+	var ok bool
+	if clip, ok = clipSegment(clip, tangent.Neg(), -tangent.Dot(v1)); !ok {
+		return Manifold2[T]{}, false
+	}
+	if clip, ok = clipSegment(clip, tangent, tangent.Dot(v2)); !ok {
+		return Manifold2[T]{}, false
+	}
+
+	m := Manifold2[T]{Normal: n, Depth: -sep}
+	if flip {
+		m.Normal = n.Neg()
+	}
+	for _, c := range clip {
+		if s := n.Dot(c.Sub(v1)); s <= tol {
+			m.Points[m.Count] = ManifoldPoint2[T]{Point: c, Depth: -s}
+			m.Count++
+		}
+	}
+	return m, m.Count > 0
+}
+
+/**
+ * CollideCircle returns the contact manifold between the polygon and the circle and a boolean indicating whether
+ * they overlap. The normal points from the polygon towards the circle and the single contact point is the deepest
+ * point of the circle.
+ * For example:
+ *   Polygon2FromAABB2(AABB2[float64]{Max: {1, 1}}).CollideCircle(NewCircle(Vec2[float64]{1.5, 0.5}, 1))
+ *   returns a manifold with Normal {1, 0}, Depth 0.5 and the point {0.5, 0.5}
+ */
+func (p Polygon2[T]) CollideCircle(c Circle[T]) (Manifold2[T], bool) {
+	if len(p) < 3 {
+		return Manifold2[T]{}, false
+	}
+	edge := 0
+	var sep T
+	for i, v := range p {
+		if s := p.EdgeNormal(i).Dot(c.Center.Sub(v)); i == 0 || s > sep {
+			edge, sep = i, s
+		}
+	}
+	if sep > c.Radius {
+		return Manifold2[T]{}, false
+	}
+
+	n := p.EdgeNormal(edge)
+	depth := c.Radius - sep
+	if sep > 0 {
+		// The center is outside the polygon, so the closest feature may be a vertex rather than the face.
+		seg := LineSegment[T]{A: vec2To3(p[edge]), B: vec2To3(p[(edge+1)%len(p)])}
+		q := seg.ClosestPoint(vec2To3(c.Center))
+		d := c.Center.Sub(Vec2[T]{X: q.X, Y: q.Y})
+		dist := d.Mag()
+		if dist > c.Radius {
+			return Manifold2[T]{}, false
+		}
+		if dist > 0 {
+			n = d.Scale(1 / dist)
+		}
+		depth = c.Radius - dist
+	}
+
+	m := Manifold2[T]{Normal: n, Depth: depth, Count: 1}
+	m.Points[0] = ManifoldPoint2[T]{Point: c.Center.Sub(n.Scale(c.Radius)), Depth: depth}
+	return m, true
+}
+
+// maxSeparation returns the largest distance by which other lies outside one of p's edges and the index of that edge.
+// A negative result means every edge is penetrated.
+func (p Polygon2[T]) maxSeparation(other Polygon2[T]) (T, int) {
+	var best T
+	edge := -1
+	for i, v := range p {
+		n := p.EdgeNormal(i)
+		var s T
+		for j, w := range other {
+			if d := n.Dot(w.Sub(v)); j == 0 || d < s {
+				s = d
+			}
+		}
+		if edge < 0 || s > best {
+			best, edge = s, i
+		}
+	}
+	return best, edge
+}
+
+// signedArea returns the area of the polygon, which is negative if its vertices are clockwise.
+func (p Polygon2[T]) signedArea() T {
+	var area T
+	for i, a := range p {
+		b := p[(i+1)%len(p)]
+		area += a.X*b.Y - a.Y*b.X
+	}
+	return area / 2
+}
+
+// reverse reverses the order of the vertices in place.
+func (p Polygon2[T]) reverse() {
+	for i, j := 0, len(p)-1; i < j; i, j = i+1, j-1 {
+		p[i], p[j] = p[j], p[i]
+	}
+}
+
+// clipSegment keeps the part of the segment where n·x <= offset, reporting false if nothing remains.
+func clipSegment[T Numeric](seg [2]Vec2[T], n Vec2[T], offset T) ([2]Vec2[T], bool) {
+	d0 := n.Dot(seg[0]) - offset
+	d1 := n.Dot(seg[1]) - offset
+	switch {
+	case d0 > 0 && d1 > 0:
+		return seg, false
+	case d0 > 0:
+		seg[0] = seg[0].Add(seg[1].Sub(seg[0]).Scale(d0 / (d0 - d1)))
+	case d1 > 0:
+		seg[1] = seg[1].Add(seg[0].Sub(seg[1]).Scale(d1 / (d1 - d0)))
+	}
+	return seg, true
+}
+
+// vec2To3 lifts a 2D point into the Z = 0 plane.
+func vec2To3[T Numeric](v Vec2[T]) Vec3[T] {
+	return Vec3[T]{X: v.X, Y: v.Y}
+}
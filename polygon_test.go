@@ -0,0 +1,117 @@
+package bm
+
+import (
+	"math"
+	"testing"
+)
+
+// TestPolygon2 tests polygon construction, area, centroid and containment.
+func TestPolygon2(t *testing.T) {
+	p := NewPolygon2(NewVec2[float64](0, 0), NewVec2[float64](0, 2), NewVec2[float64](2, 2), NewVec2[float64](2, 0))
+	if p.signedArea() <= 0 {
+		t.Errorf("NewPolygon2() = %v, want counter-clockwise vertices", p)
+	}
+	if got := p.Area(); got != 4 {
+		t.Errorf("Polygon2 Area() = %v, want 4", got)
+	}
+	if got, expected := p.Centroid(), NewVec2[float64](1, 1); got != expected {
+		t.Errorf("Polygon2 Centroid() = %v, want %v", got, expected)
+	}
+	if !p.Contains(NewVec2[float64](1, 1.5)) || p.Contains(NewVec2[float64](3, 1)) {
+		t.Errorf("Polygon2 Contains() gave the wrong answer")
+	}
+	mirrored := p.Transform(Scale2D(NewVec2[float64](-1, 1)))
+	if mirrored.signedArea() <= 0 {
+		t.Errorf("Polygon2 Transform() with a reflection = %v, want counter-clockwise vertices", mirrored)
+	}
+	if got, expected := mirrored.Bounds(), (AABB2[float64]{Min: NewVec2[float64](-2, 0), Max: NewVec2[float64](0, 2)}); got != expected {
+		t.Errorf("Polygon2 Bounds() = %v, want %v", got, expected)
+	}
+}
+
+// TestPolygon2Collide tests SAT overlap, minimum translation vectors and clipped contact points between polygons.
+func TestPolygon2Collide(t *testing.T) {
+	box := Polygon2FromAABB2(AABB2[float64]{Max: NewVec2[float64](1, 1)})
+	diamond := NewPolygon2(NewVec2[float64](0, -1), NewVec2[float64](1, 0), NewVec2[float64](0, 1), NewVec2[float64](-1, 0))
+
+	tests := []struct {
+		name    string
+		a, b    Polygon2[float64]
+		overlap bool
+		mtv     Vec2[float64]
+		points  []Vec2[float64]
+	}{
+		{"separated", box, box.Transform(Translate2D(NewVec2[float64](3, 0))), false, Vec2[float64]{}, nil},
+		{"face to face", box, Polygon2FromAABB2(AABB2[float64]{Min: NewVec2[float64](0.75, 0.25), Max: NewVec2[float64](2, 0.75)}),
+			true, NewVec2[float64](0.25, 0), []Vec2[float64]{{X: 0.75, Y: 0.75}, {X: 0.75, Y: 0.25}}},
+		{"clipped face", box, Polygon2FromAABB2(AABB2[float64]{Min: NewVec2[float64](-1, 0.9), Max: NewVec2[float64](2, 3)}),
+			true, NewVec2[float64](0, 0.1), []Vec2[float64]{{X: 0, Y: 0.9}, {X: 1, Y: 0.9}}},
+		{"vertex into face", box, diamond.Transform(Translate2D(NewVec2[float64](0.5, 1.75))),
+			true, NewVec2[float64](0, 0.25), []Vec2[float64]{{X: 0.5, Y: 0.75}}},
+		{"reference face on B", diamond.Transform(Translate2D(NewVec2[float64](0.5, -0.75))), box,
+			true, NewVec2[float64](0, 0.25), []Vec2[float64]{{X: 0.5, Y: 0.25}}},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Overlaps(tt.b); got != tt.overlap {
+			t.Errorf("%s: Overlaps() = %v, want %v", tt.name, got, tt.overlap)
+		}
+		m, ok := tt.a.Collide(tt.b)
+		if ok != tt.overlap {
+			t.Errorf("%s: Collide() ok = %v, want %v", tt.name, ok, tt.overlap)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if mtv := m.MTV(); !approxEqual(mtv.X, tt.mtv.X, 1e-9) || !approxEqual(mtv.Y, tt.mtv.Y, 1e-9) {
+			t.Errorf("%s: Collide() MTV = %v, want %v", tt.name, mtv, tt.mtv)
+		}
+		if m.Count != len(tt.points) {
+			t.Errorf("%s: Collide() Count = %v, want %v", tt.name, m.Count, len(tt.points))
+			continue
+		}
+		for i, p := range tt.points {
+			if got := m.Points[i].Point; !approxEqual(got.X, p.X, 1e-9) || !approxEqual(got.Y, p.Y, 1e-9) {
+				t.Errorf("%s: Collide() point %d = %v, want %v", tt.name, i, got, p)
+			}
+		}
+		if mtv, _ := tt.a.MTV(tt.b); mtv != m.MTV() {
+			t.Errorf("%s: MTV() = %v, want %v", tt.name, mtv, m.MTV())
+		}
+	}
+}
+
+// TestPolygon2CollideCircle tests contact generation between a polygon and a circle.
+func TestPolygon2CollideCircle(t *testing.T) {
+	box := Polygon2FromAABB2(AABB2[float64]{Max: NewVec2[float64](1, 1)})
+
+	tests := []struct {
+		name   string
+		circle Circle[float64]
+		ok     bool
+		normal Vec2[float64]
+		depth  float64
+	}{
+		{"face", NewCircle(NewVec2[float64](1.5, 0.5), 1), true, NewVec2[float64](1, 0), 0.5},
+		{"vertex", NewCircle(NewVec2[float64](1.5, 1.5), 1), true, NewVec2[float64](math.Sqrt2/2, math.Sqrt2/2), 1 - math.Sqrt2/2},
+		{"center inside", NewCircle(NewVec2[float64](0.5, 0.2), 0.5), true, NewVec2[float64](0, -1), 0.7},
+		{"near vertex but apart", NewCircle(NewVec2[float64](1.8, 1.8), 1), false, Vec2[float64]{}, 0},
+		{"far", NewCircle(NewVec2[float64](5, 0.5), 1), false, Vec2[float64]{}, 0},
+	}
+	for _, tt := range tests {
+		m, ok := box.CollideCircle(tt.circle)
+		if ok != tt.ok {
+			t.Errorf("%s: CollideCircle() ok = %v, want %v", tt.name, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if !approxEqual(m.Depth, tt.depth, 1e-9) || !approxEqual(m.Normal.X, tt.normal.X, 1e-9) || !approxEqual(m.Normal.Y, tt.normal.Y, 1e-9) {
+			t.Errorf("%s: CollideCircle() = normal %v depth %v, want normal %v depth %v", tt.name, m.Normal, m.Depth, tt.normal, tt.depth)
+		}
+		if expected := tt.circle.Center.Sub(tt.normal.Scale(tt.circle.Radius)); m.Count != 1 || !approxEqual(m.Points[0].Point.Dist(expected), 0, 1e-9) {
+			t.Errorf("%s: CollideCircle() points = %v, want %v", tt.name, m.Points[:m.Count], expected)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package bm
+
+// QR is the Householder QR decomposition of an m x n matrix A with m >= n, so that A = Q*R with Q having
+// orthonormal columns and R upper triangular. Decompositions are intended for floating-point types.
+type QR[T Numeric] struct {
+	qr    Dense[T]
+	rdiag []T
+	tol   float64
+}
+
+/**
+ * QR returns the Householder QR decomposition of the matrix. It panics if the matrix has fewer rows than columns.
+ * For example:
+ *   NewDense(3, 2, []float64{1, 0, 0, 1, 1, 1}).QR().R() returns an upper triangular 2x2 matrix
+ */
+func (d Dense[T]) QR() QR[T] {
+	if d.rows < d.cols {
+		panic("bm: QR decomposition needs at least as many rows as columns")
+	}
+	m, n := d.rows, d.cols
+	f := QR[T]{qr: d.Clone(), rdiag: make([]T, n)}
+	a := f.qr.data
+	var scale float64
+	for _, v := range a {
+		scale = Max(scale, float64(Abs(v)))
+	}
+	f.tol = singularTolerance * scale
+
+	for k := 0; k < n; k++ {
+		var nrm T
+		for i := k; i < m; i++ {
+			nrm = Hypot(nrm, a[i*n+k])
+		}
+		if nrm != 0 {
+			// Build the Householder vector in column k so that it reflects the column onto -nrm * e_k.
+			if a[k*n+k] < 0 {
+				nrm = -nrm
+			}
+			for i := k; i < m; i++ {
+				a[i*n+k] /= nrm
+			}
+			a[k*n+k] += 1
+			for j := k + 1; j < n; j++ {
+				var s T
+				for i := k; i < m; i++ {
+					s += a[i*n+k] * a[i*n+j]
+				}
+				s = -s / a[k*n+k]
+				for i := k; i < m; i++ {
+					a[i*n+j] += s * a[i*n+k]
+				}
+			}
+		}
+		f.rdiag[k] = -nrm
+	}
+	return f
+}
+
+/**
+ * QR returns the Householder QR decomposition of the matrix.
+ * For example:
+ *   IdentityMat2[float64]().QR().Q() returns IdentityDense[float64](2) up to signs
+ */
+func (m Mat2[T]) QR() QR[T] {
+	return DenseFromMat2(m).QR()
+}
+
+/**
+ * QR returns the Householder QR decomposition of the matrix.
+ * For example:
+ *   RotateX[float64](1).QR().R() returns IdentityDense[float64](3) up to signs
+ */
+func (m Mat3[T]) QR() QR[T] {
+	return DenseFromMat3(m).QR()
+}
+
+/**
+ * QR returns the Householder QR decomposition of the matrix.
+ * For example:
+ *   IdentityMat4[float64]().QR().R() returns IdentityDense[float64](4) up to signs
+ */
+func (m Mat4[T]) QR() QR[T] {
+	return DenseFromMat4(m).QR()
+}
+
+/**
+ * FullRank reports whether R has no numerically zero diagonal element, so that least-squares solutions are unique.
+ * For example:
+ *   NewDense(3, 2, []float64{1, 2, 2, 4, 3, 6}).QR().FullRank() returns false
+ */
+func (f QR[T]) FullRank() bool {
+	for _, d := range f.rdiag {
+		if float64(Abs(d)) <= f.tol {
+			return false
+		}
+	}
+	return true
+}
+
+/**
+ * Q returns the m x n factor with orthonormal columns.
+ * For example:
+ *   a.QR().Q().Transpose().Mul(a.QR().Q()) returns the n x n identity matrix
+ */
+func (f QR[T]) Q() Dense[T] {
+	m, n := f.qr.rows, f.qr.cols
+	a := f.qr.data
+	q := NewDense[T](m, n, nil)
+	for k := n - 1; k >= 0; k-- {
+		q.data[k*n+k] = 1
+		for j := k; j < n; j++ {
+			if a[k*n+k] == 0 {
+				continue
+			}
+			var s T
+			for i := k; i < m; i++ {
+				s += a[i*n+k] * q.data[i*n+j]
+			}
+			s = -s / a[k*n+k]
+			for i := k; i < m; i++ {
+				q.data[i*n+j] += s * a[i*n+k]
+			}
+		}
+	}
+	return q
+}
+
+/**
+ * R returns the n x n upper triangular factor.
+ * For example:
+ *   NewDense(2, 2, []float64{3, 0, 4, 5}).QR().R() returns the matrix [[-5, -4], [0, -3]]
+ */
+func (f QR[T]) R() Dense[T] {
+	n := f.qr.cols
+	r := NewDense[T](n, n, nil)
+	for i := 0; i < n; i++ {
+		r.data[i*n+i] = f.rdiag[i]
+		copy(r.data[i*n+i+1:(i+1)*n], f.qr.data[i*n+i+1:(i+1)*n])
+	}
+	return r
+}
+
+/**
+ * LeastSquares returns the x minimizing |A*x - b| and the norm of the residual A*x - b, or ErrSingular if A is
+ * not of full column rank. It panics if b does not have one element per row of A.
+ * For example:
+ *   NewDense(3, 1, []float64{1, 1, 1}).QR().LeastSquares(Vector[float64]{1, 2, 6}) returns (Vector[float64]{3}, math.Sqrt(14), nil)
+ */
+func (f QR[T]) LeastSquares(b Vector[T]) (Vector[T], T, error) {
+	m, n := f.qr.rows, f.qr.cols
+	b.mustLen(m)
+	if !f.FullRank() {
+		return nil, 0, ErrSingular
+	}
+	a := f.qr.data
+	y := b.Clone()
+
+	// Apply the Householder reflections to compute Qᵀb; the entries past n hold the residual.
+	for k := 0; k < n; k++ {
+		var s T
+		for i := k; i < m; i++ {
+			s += a[i*n+k] * y[i]
+		}
+		s = -s / a[k*n+k]
+		for i := k; i < m; i++ {
+			y[i] += s * a[i*n+k]
+		}
+	}
+
+	x := make(Vector[T], n)
+	for k := n - 1; k >= 0; k-- {
+		sum := y[k]
+		for j := k + 1; j < n; j++ {
+			sum -= a[k*n+j] * x[j]
+		}
+		x[k] = sum / f.rdiag[k]
+	}
+	return x, y[n:].Mag(), nil
+}
+
+/**
+ * LeastSquares returns the x minimizing |a*x - b| for an overdetermined system using Householder QR, together with
+ * the norm of the residual a*x - b. It returns ErrSingular if a is not of full column rank.
+ * For example:
+ *   LeastSquares(NewDense(3, 2, []float64{1, 0, 1, 1, 1, 2}), Vector[float64]{1, 2, 3}) returns (Vector[float64]{1, 1}, 0, nil)
+ */
+func LeastSquares[T Numeric](a Dense[T], b Vector[T]) (Vector[T], T, error) {
+	return a.QR().LeastSquares(b)
+}
@@ -0,0 +1,85 @@
+package bm
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// TestQR tests that the Householder factors are orthonormal and triangular and reproduce the matrix.
+func TestQR(t *testing.T) {
+	a := NewDense(5, 3, []float64{
+		1, 2, 0,
+		-1, 0, 3,
+		4, 1, 1,
+		0, 2, -2,
+		3, -1, 1,
+	})
+	f := a.QR()
+	q, r := f.Q(), f.R()
+	if !denseApproxEqual(q.Transpose().Mul(q), IdentityDense[float64](3), 1e-12) {
+		t.Errorf("QR Qᵀ*Q = %v, want identity", q.Transpose().Mul(q))
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < i; j++ {
+			if r.At(i, j) != 0 {
+				t.Errorf("QR R() = %v, want upper triangular", r)
+			}
+		}
+	}
+	if !denseApproxEqual(q.Mul(r), a, 1e-12) {
+		t.Errorf("QR Q*R = %v, want %v", q.Mul(r), a)
+	}
+
+	m := Mat3[float64]{{0, 2, 1}, {1, 0, 3}, {4, 1, 0}}
+	f3 := m.QR()
+	if !denseApproxEqual(f3.Q().Mul(f3.R()), DenseFromMat3(m), 1e-12) {
+		t.Errorf("Mat3 QR Q*R = %v, want %v", f3.Q().Mul(f3.R()), m)
+	}
+}
+
+// TestLeastSquares tests least-squares fits of an exact and a noisy model and the rank-deficient case.
+func TestLeastSquares(t *testing.T) {
+	// Fit the plane z = a*x + b*y + c through points lying exactly on z = 2x - y + 3.
+	points := []Vec3[float64]{{0, 0, 3}, {1, 0, 5}, {0, 1, 2}, {2, 3, 4}, {-1, 2, -1}}
+	a := NewDense[float64](len(points), 3, nil)
+	b := NewVector[float64](len(points))
+	for i, p := range points {
+		a.Set(i, 0, p.X)
+		a.Set(i, 1, p.Y)
+		a.Set(i, 2, 1)
+		b[i] = p.Z
+	}
+	x, residual, err := LeastSquares(a, b)
+	if err != nil {
+		t.Fatalf("LeastSquares() error = %v", err)
+	}
+	if x.Sub(Vector[float64]{2, -1, 3}).Mag() > 1e-12 || residual > 1e-12 {
+		t.Errorf("LeastSquares() = %v, %v, want [2, -1, 3], 0", x, residual)
+	}
+
+	// Fitting a constant gives the mean and the residual of the deviations.
+	x, residual, err = NewDense(3, 1, []float64{1, 1, 1}).QR().LeastSquares(Vector[float64]{1, 2, 6})
+	if err != nil || !approxEqual(x[0], 3, 1e-12) || !approxEqual(residual, math.Sqrt(14), 1e-12) {
+		t.Errorf("QR LeastSquares() = %v, %v, %v, want [3], %v", x, residual, err, math.Sqrt(14))
+	}
+
+	// A noisy line must match the normal equations solution.
+	line := NewDense(4, 2, []float64{1, 0, 1, 1, 1, 2, 1, 3})
+	y := Vector[float64]{1.1, 1.9, 3.2, 3.9}
+	x, residual, err = LeastSquares(line, y)
+	if err != nil {
+		t.Fatalf("LeastSquares() error = %v", err)
+	}
+	normal, err := line.Transpose().Mul(line).Solve(line.Transpose().MulVec(y))
+	if err != nil || x.Sub(normal).Mag() > 1e-12 {
+		t.Errorf("LeastSquares() = %v, want %v", x, normal)
+	}
+	if expected := line.MulVec(x).Sub(y).Mag(); !approxEqual(residual, expected, 1e-12) {
+		t.Errorf("LeastSquares() residual = %v, want %v", residual, expected)
+	}
+
+	if _, _, err := LeastSquares(NewDense(3, 2, []float64{1, 2, 2, 4, 3, 6}), Vector[float64]{1, 2, 3}); !errors.Is(err, ErrSingular) {
+		t.Errorf("LeastSquares() of a rank-deficient matrix error = %v, want ErrSingular", err)
+	}
+}
@@ -0,0 +1,329 @@
+package bm
+
+import "fmt"
+
+// Quat represents a quaternion with vector part X, Y, Z and scalar part W.
+type Quat[T Numeric] struct {
+	X, Y, Z, W T
+}
+
+/**
+ * NewQuat returns a quaternion with the given vector part x, y, z and scalar part w.
+ * For example:
+ *   NewQuat[float64](0, 0, 0, 1) returns the identity rotation
+ */
+func NewQuat[T Numeric](x, y, z, w T) Quat[T] {
+	return Quat[T]{X: x, Y: y, Z: z, W: w}
+}
+
+/**
+ * IdentityQuat returns the identity quaternion, which represents no rotation.
+ * For example:
+ *   IdentityQuat[float64]() returns Quat[float64]{0, 0, 0, 1}
+ */
+func IdentityQuat[T Numeric]() Quat[T] {
+	return Quat[T]{W: 1}
+}
+
+/**
+ * QuatFromAxisAngle returns a quaternion rotating by angle radians around axis. The axis does not need to be normalized.
+ * For example:
+ *   QuatFromAxisAngle(NewVec3[float64](0, 0, 1), math.Pi/2) returns Quat[float64]{0, 0, 0.7071, 0.7071}
+ */
+func QuatFromAxisAngle[T Numeric](axis Vec3[T], angle T) Quat[T] {
+	axis = axis.Norm()
+	half := angle / 2
+	s := Sin(half)
+	return Quat[T]{X: axis.X * s, Y: axis.Y * s, Z: axis.Z * s, W: Cos(half)}
+}
+
+/**
+ * QuatFromEuler returns a quaternion from Euler angles in radians. The rotations are applied around the X-axis
+ * first, then Y, then Z, matching RotateZ(z).Mul(RotateY(y)).Mul(RotateX(x)).
+ * For example:
+ *   QuatFromEuler[float64](0, 0, math.Pi/2) returns the same rotation as RotateZ(math.Pi/2)
+ */
+func QuatFromEuler[T Numeric](x, y, z T) Quat[T] {
+	cx, sx := Cos(x/2), Sin(x/2)
+	cy, sy := Cos(y/2), Sin(y/2)
+	cz, sz := Cos(z/2), Sin(z/2)
+	return Quat[T]{
+		X: sx*cy*cz - cx*sy*sz,
+		Y: cx*sy*cz + sx*cy*sz,
+		Z: cx*cy*sz - sx*sy*cz,
+		W: cx*cy*cz + sx*sy*sz,
+	}
+}
+
+/**
+ * QuatFromMat3 returns the quaternion for a pure rotation matrix.
+ * For example:
+ *   QuatFromMat3(RotateZ(math.Pi/2)) returns Quat[float64]{0, 0, 0.7071, 0.7071}
+ */
+func QuatFromMat3[T Numeric](m Mat3[T]) Quat[T] {
+	var q Quat[T]
+	trace := m[0][0] + m[1][1] + m[2][2]
+	switch {
+	case trace > 0:
+		s := Sqrt(trace+1) * 2
+		q.W = s / 4
+		q.X = (m[2][1] - m[1][2]) / s
+		q.Y = (m[0][2] - m[2][0]) / s
+		q.Z = (m[1][0] - m[0][1]) / s
+	case m[0][0] > m[1][1] && m[0][0] > m[2][2]:
+		s := Sqrt(1+m[0][0]-m[1][1]-m[2][2]) * 2
+		q.W = (m[2][1] - m[1][2]) / s
+		q.X = s / 4
+		q.Y = (m[0][1] + m[1][0]) / s
+		q.Z = (m[0][2] + m[2][0]) / s
+	case m[1][1] > m[2][2]:
+		s := Sqrt(1+m[1][1]-m[0][0]-m[2][2]) * 2
+		q.W = (m[0][2] - m[2][0]) / s
+		q.X = (m[0][1] + m[1][0]) / s
+		q.Y = s / 4
+		q.Z = (m[1][2] + m[2][1]) / s
+	default:
+		s := Sqrt(1+m[2][2]-m[0][0]-m[1][1]) * 2
+		q.W = (m[1][0] - m[0][1]) / s
+		q.X = (m[0][2] + m[2][0]) / s
+		q.Y = (m[1][2] + m[2][1]) / s
+		q.Z = s / 4
+	}
+	return q
+}
+
+/**
+ * QuatFromMat4 returns the quaternion for the rotation stored in the upper-left 3x3 block of m.
+ * For example:
+ *   QuatFromMat4(IdentityMat4[float64]().RotateX(math.Pi/2)) returns Quat[float64]{0.7071, 0, 0, 0.7071}
+ */
+func QuatFromMat4[T Numeric](m Mat4[T]) Quat[T] {
+	return QuatFromMat3(Mat3[T]{
+		{m[0][0], m[0][1], m[0][2]},
+		{m[1][0], m[1][1], m[1][2]},
+		{m[2][0], m[2][1], m[2][2]},
+	})
+}
+
+/**
+ * Add adds another quaternion component-wise and returns the result.
+ * For example:
+ *   Quat[int]{1, 2, 3, 4}.Add(Quat[int]{1, 1, 1, 1}) returns Quat[int]{2, 3, 4, 5}
+ */
+func (q Quat[T]) Add(other Quat[T]) Quat[T] {
+	return Quat[T]{X: q.X + other.X, Y: q.Y + other.Y, Z: q.Z + other.Z, W: q.W + other.W}
+}
+
+/**
+ * Sub subtracts another quaternion component-wise and returns the result.
+ * For example:
+ *   Quat[int]{2, 3, 4, 5}.Sub(Quat[int]{1, 1, 1, 1}) returns Quat[int]{1, 2, 3, 4}
+ */
+func (q Quat[T]) Sub(other Quat[T]) Quat[T] {
+	return Quat[T]{X: q.X - other.X, Y: q.Y - other.Y, Z: q.Z - other.Z, W: q.W - other.W}
+}
+
+/**
+ * Scale multiplies every component of the quaternion by a scalar and returns the result.
+ * For example:
+ *   Quat[int]{1, 2, 3, 4}.Scale(2) returns Quat[int]{2, 4, 6, 8}
+ */
+func (q Quat[T]) Scale(scalar T) Quat[T] {
+	return Quat[T]{X: q.X * scalar, Y: q.Y * scalar, Z: q.Z * scalar, W: q.W * scalar}
+}
+
+/**
+ * Neg negates every component of the quaternion. The result represents the same rotation as q.
+ * For example:
+ *   Quat[int]{1, 2, 3, 4}.Neg() returns Quat[int]{-1, -2, -3, -4}
+ */
+func (q Quat[T]) Neg() Quat[T] {
+	return Quat[T]{X: -q.X, Y: -q.Y, Z: -q.Z, W: -q.W}
+}
+
+/**
+ * Dot returns the four-dimensional dot product of two quaternions.
+ * For example:
+ *   Quat[int]{1, 2, 3, 4}.Dot(Quat[int]{1, 1, 1, 1}) returns 10
+ */
+func (q Quat[T]) Dot(other Quat[T]) T {
+	return q.X*other.X + q.Y*other.Y + q.Z*other.Z + q.W*other.W
+}
+
+/**
+ * Mul returns the Hamilton product q * other. The resulting rotation applies other first and then q.
+ * For example:
+ *   QuatFromAxisAngle(zAxis, math.Pi/4).Mul(QuatFromAxisAngle(zAxis, math.Pi/4)) rotates by math.Pi/2 around zAxis
+ */
+func (q Quat[T]) Mul(other Quat[T]) Quat[T] {
+	return Quat[T]{
+		X: q.W*other.X + q.X*other.W + q.Y*other.Z - q.Z*other.Y,
+		Y: q.W*other.Y - q.X*other.Z + q.Y*other.W + q.Z*other.X,
+		Z: q.W*other.Z + q.X*other.Y - q.Y*other.X + q.Z*other.W,
+		W: q.W*other.W - q.X*other.X - q.Y*other.Y - q.Z*other.Z,
+	}
+}
+
+/**
+ * Conjugate returns the quaternion with its vector part negated. For unit quaternions this is the inverse rotation.
+ * For example:
+ *   Quat[int]{1, 2, 3, 4}.Conjugate() returns Quat[int]{-1, -2, -3, 4}
+ */
+func (q Quat[T]) Conjugate() Quat[T] {
+	return Quat[T]{X: -q.X, Y: -q.Y, Z: -q.Z, W: q.W}
+}
+
+/**
+ * Inverse returns the multiplicative inverse of the quaternion and a boolean indicating success.
+ * If the quaternion has zero length, it returns false indicating that the inverse does not exist.
+ * For example:
+ *   Quat[float64]{0, 0, 0, 2}.Inverse() returns (Quat[float64]{0, 0, 0, 0.5}, true)
+ *   Quat[float64]{}.Inverse() returns (Quat[float64]{}, false)
+ */
+func (q Quat[T]) Inverse() (Quat[T], bool) {
+	lenSq := q.Dot(q)
+	if lenSq == 0 {
+		return Quat[T]{}, false
+	}
+	c := q.Conjugate()
+	return Quat[T]{X: c.X / lenSq, Y: c.Y / lenSq, Z: c.Z / lenSq, W: c.W / lenSq}, true
+}
+
+/**
+ * Mag returns the length of the quaternion.
+ * For example:
+ *   Quat[float64]{1, 1, 1, 1}.Mag() returns 2
+ */
+func (q Quat[T]) Mag() T {
+	return Sqrt(q.Dot(q))
+}
+
+/**
+ * Norm returns the quaternion scaled to unit length. A zero quaternion is returned unchanged.
+ * For example:
+ *   Quat[float64]{0, 0, 0, 2}.Norm() returns Quat[float64]{0, 0, 0, 1}
+ */
+func (q Quat[T]) Norm() Quat[T] {
+	mag := q.Mag()
+	if mag == 0 {
+		return Quat[T]{}
+	}
+	return Quat[T]{X: q.X / mag, Y: q.Y / mag, Z: q.Z / mag, W: q.W / mag}
+}
+
+/**
+ * Rotate rotates the vector v by the unit quaternion q.
+ * For example:
+ *   QuatFromAxisAngle(NewVec3[float64](0, 0, 1), math.Pi/2).Rotate(NewVec3[float64](1, 0, 0)) returns approximately Vec3[float64]{0, 1, 0}
+ */
+func (q Quat[T]) Rotate(v Vec3[T]) Vec3[T] {
+	u := Vec3[T]{X: q.X, Y: q.Y, Z: q.Z}
+	t := u.Cross(v).Scale(2)
+	return v.Add(t.Scale(q.W)).Add(u.Cross(t))
+}
+
+/**
+ * AxisAngle returns the rotation axis and angle in radians of the unit quaternion.
+ * For the identity rotation the axis is the X-axis and the angle is 0.
+ * For example:
+ *   QuatFromAxisAngle(NewVec3[float64](0, 1, 0), 1).AxisAngle() returns (Vec3[float64]{0, 1, 0}, 1)
+ */
+func (q Quat[T]) AxisAngle() (Vec3[T], T) {
+	if q.W < 0 {
+		q = q.Neg()
+	}
+	s := Sqrt(1 - Clamp(q.W*q.W, 0, 1))
+	angle := Acos(Clamp(q.W, 0, 1)) * 2
+	if s == 0 {
+		return Vec3[T]{X: 1}, angle
+	}
+	return Vec3[T]{X: q.X / s, Y: q.Y / s, Z: q.Z / s}, angle
+}
+
+/**
+ * Euler returns the Euler angles in radians of the unit quaternion, using the same convention as QuatFromEuler.
+ * For example:
+ *   QuatFromEuler[float64](0.1, 0.2, 0.3).Euler() returns approximately Vec3[float64]{0.1, 0.2, 0.3}
+ */
+func (q Quat[T]) Euler() Vec3[T] {
+	m := q.Mat3()
+	return Vec3[T]{
+		X: Atan2(m[2][1], m[2][2]),
+		Y: T(Asin(Clamp(-float64(m[2][0]), -1, 1))),
+		Z: Atan2(m[1][0], m[0][0]),
+	}
+}
+
+/**
+ * Nlerp linearly interpolates between two unit quaternions along the shortest path and normalizes the result.
+ * For example:
+ *   IdentityQuat[float64]().Nlerp(q, 0.5) returns a rotation roughly halfway to q
+ */
+func (q Quat[T]) Nlerp(other Quat[T], t T) Quat[T] {
+	if q.Dot(other) < 0 {
+		other = other.Neg()
+	}
+	return q.Add(other.Sub(q).Scale(t)).Norm()
+}
+
+/**
+ * Slerp performs spherical linear interpolation between two unit quaternions along the shortest path.
+ * If the rotations are nearly identical it falls back to Nlerp.
+ * For example:
+ *   IdentityQuat[float64]().Slerp(QuatFromAxisAngle(zAxis, math.Pi/2), 0.5) rotates by math.Pi/4 around zAxis
+ */
+func (q Quat[T]) Slerp(other Quat[T], t T) Quat[T] {
+	cosTheta := q.Dot(other)
+	if cosTheta < 0 {
+		other = other.Neg()
+		cosTheta = -cosTheta
+	}
+	if float64(cosTheta) > 0.9995 {
+		return q.Nlerp(other, t)
+	}
+	theta := Acos(cosTheta)
+	sinTheta := Sin(theta)
+	a := Sin((1-t)*theta) / sinTheta
+	b := Sin(t*theta) / sinTheta
+	return q.Scale(a).Add(other.Scale(b))
+}
+
+/**
+ * Mat3 returns the 3x3 rotation matrix of the unit quaternion.
+ * For example:
+ *   QuatFromAxisAngle(NewVec3[float64](0, 0, 1), math.Pi/2).Mat3() returns approximately RotateZ(math.Pi/2)
+ */
+func (q Quat[T]) Mat3() Mat3[T] {
+	xx, yy, zz := q.X*q.X, q.Y*q.Y, q.Z*q.Z
+	xy, xz, yz := q.X*q.Y, q.X*q.Z, q.Y*q.Z
+	wx, wy, wz := q.W*q.X, q.W*q.Y, q.W*q.Z
+	return Mat3[T]{
+		{1 - 2*(yy+zz), 2 * (xy - wz), 2 * (xz + wy)},
+		{2 * (xy + wz), 1 - 2*(xx+zz), 2 * (yz - wx)},
+		{2 * (xz - wy), 2 * (yz + wx), 1 - 2*(xx+yy)},
+	}
+}
+
+/**
+ * Mat4 returns the 4x4 homogeneous rotation matrix of the unit quaternion.
+ * For example:
+ *   QuatFromAxisAngle(NewVec3[float64](1, 0, 0), math.Pi/2).Mat4() returns approximately IdentityMat4[float64]().RotateX(math.Pi/2)
+ */
+func (q Quat[T]) Mat4() Mat4[T] {
+	r := q.Mat3()
+	return Mat4[T]{
+		{r[0][0], r[0][1], r[0][2], 0},
+		{r[1][0], r[1][1], r[1][2], 0},
+		{r[2][0], r[2][1], r[2][2], 0},
+		{0, 0, 0, 1},
+	}
+}
+
+/**
+ * String returns a string representation of the quaternion.
+ * For example:
+ *   Quat[int]{1, 2, 3, 4}.String() returns "(1, 2, 3, 4)"
+ */
+func (q Quat[T]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v)", q.X, q.Y, q.Z, q.W)
+}
@@ -0,0 +1,75 @@
+package bm
+
+import (
+	"testing"
+)
+
+// TestQuatRotate tests rotating a vector by an axis-angle quaternion.
+func TestQuatRotate(t *testing.T) {
+	q := QuatFromAxisAngle(NewVec3[float64](0, 0, 1), Pi/2)
+	expected := NewVec3[float64](0, 1, 0)
+	rotated := q.Rotate(NewVec3[float64](1, 0, 0))
+
+	if !vec3ApproxEqual(rotated, expected, 1e-12) {
+		t.Errorf("Quat Rotate() = %v, want %v", rotated, expected)
+	}
+}
+
+// TestQuatMat3 tests that quaternion and matrix rotations agree.
+func TestQuatMat3(t *testing.T) {
+	x, y, z := 0.3, -0.7, 1.1
+	expected := RotateZ(z).Mul(RotateY(y)).Mul(RotateX(x))
+	q := QuatFromEuler(x, y, z)
+
+	if m := q.Mat3(); !mat3ApproxEqual(m, expected, 1e-12) {
+		t.Errorf("Quat Mat3() = %v, want %v", m, expected)
+	}
+	if back := QuatFromMat3(expected); !approxEqual(Abs(back.Dot(q)), 1, 1e-12) {
+		t.Errorf("QuatFromMat3() = %v, want %v", back, q)
+	}
+	if euler := q.Euler(); !vec3ApproxEqual(euler, NewVec3(x, y, z), 1e-12) {
+		t.Errorf("Quat Euler() = %v, want %v", euler, NewVec3(x, y, z))
+	}
+}
+
+// TestQuatMat4 tests conversion to and from Mat4.
+func TestQuatMat4(t *testing.T) {
+	expected := IdentityMat4[float64]().RotateX(Pi / 3)
+	q := QuatFromMat4(expected)
+
+	if m := q.Mat4(); !mat4ApproxEqual(m, expected, 1e-12) {
+		t.Errorf("Quat Mat4() = %v, want %v", m, expected)
+	}
+}
+
+// TestQuatMulInverse tests that a quaternion multiplied by its inverse is the identity.
+func TestQuatMulInverse(t *testing.T) {
+	q := Quat[float64]{1, 2, 3, 4}
+	inv, ok := q.Inverse()
+	if !ok {
+		t.Fatalf("Quat Inverse() failed for %v", q)
+	}
+	r := q.Mul(inv)
+
+	if !approxEqual(r.W, 1, 1e-12) || !vec3ApproxEqual(NewVec3(r.X, r.Y, r.Z), Vec3[float64]{}, 1e-12) {
+		t.Errorf("Quat Mul(Inverse()) = %v, want %v", r, IdentityQuat[float64]())
+	}
+	if _, ok := (Quat[float64]{}).Inverse(); ok {
+		t.Errorf("Quat Inverse() of zero quaternion succeeded")
+	}
+}
+
+// TestQuatSlerp tests spherical interpolation halfway between two rotations.
+func TestQuatSlerp(t *testing.T) {
+	axis := NewVec3[float64](0, 0, 1)
+	a := IdentityQuat[float64]()
+	b := QuatFromAxisAngle(axis, Pi/2)
+	expected := QuatFromAxisAngle(axis, Pi/4)
+
+	if s := a.Slerp(b, 0.5); !approxEqual(s.Dot(expected), 1, 1e-12) {
+		t.Errorf("Quat Slerp() = %v, want %v", s, expected)
+	}
+	if n := a.Nlerp(b, 0.5); !approxEqual(n.Dot(expected), 1, 1e-12) {
+		t.Errorf("Quat Nlerp() = %v, want %v", n, expected)
+	}
+}
@@ -0,0 +1,104 @@
+package bm
+
+// Ray2 represents a half-line in 2D space starting at Origin and extending along Direction.
+type Ray2[T Numeric] struct {
+	Origin, Direction Vec2[T]
+}
+
+// RayHit2 describes where a Ray2 hits a shape. Distance is the ray parameter of the hit, which equals the
+// distance along the ray when Direction has unit length. Normal is the unit surface normal at Point.
+type RayHit2[T Numeric] struct {
+	Distance T
+	Point    Vec2[T]
+	Normal   Vec2[T]
+}
+
+/**
+ * NewRay2 returns the ray starting at origin and extending along direction.
+ * For example:
+ *   NewRay2(Vec2[float64]{0, 0}, Vec2[float64]{1, 0}) returns a ray along the positive X-axis
+ */
+func NewRay2[T Numeric](origin, direction Vec2[T]) Ray2[T] {
+	return Ray2[T]{Origin: origin, Direction: direction}
+}
+
+/**
+ * At returns the point at parameter t along the ray.
+ * For example:
+ *   NewRay2(Vec2[int]{1, 0}, Vec2[int]{0, 2}).At(3) returns Vec2[int]{1, 6}
+ */
+func (r Ray2[T]) At(t T) Vec2[T] {
+	return r.Origin.Add(r.Direction.Scale(t))
+}
+
+/**
+ * IntersectCircle returns the first hit of the ray with the circle and a boolean indicating whether it hits at all.
+ * If the ray starts inside the circle, the exit point is returned.
+ * For example:
+ *   NewRay2(Vec2[float64]{-5, 0}, Vec2[float64]{1, 0}).IntersectCircle(NewCircle(Vec2[float64]{}, 1))
+ *   returns (RayHit2[float64]{Distance: 4, Point: {-1, 0}, Normal: {-1, 0}}, true)
+ */
+func (r Ray2[T]) IntersectCircle(c Circle[T]) (RayHit2[T], bool) {
+	m := r.Origin.Sub(c.Center)
+	a := r.Direction.Dot(r.Direction)
+	b := m.Dot(r.Direction)
+	k := m.Dot(m) - c.Radius*c.Radius
+	if a == 0 || (k > 0 && b > 0) {
+		return RayHit2[T]{}, false
+	}
+	disc := b*b - a*k
+	if disc < 0 {
+		return RayHit2[T]{}, false
+	}
+	sq := Sqrt(disc)
+	t := (-b - sq) / a
+	if t < 0 {
+		t = (-b + sq) / a
+	}
+	p := r.At(t)
+	return RayHit2[T]{Distance: t, Point: p, Normal: p.Sub(c.Center).Norm()}, true
+}
+
+/**
+ * IntersectAABB returns the first hit of the ray with the box using the slab method and a boolean indicating
+ * whether it hits at all. If the ray starts inside the box, the exit point is returned.
+ * For example:
+ *   NewRay2(Vec2[float64]{-5, 0.5}, Vec2[float64]{1, 0}).IntersectAABB(AABB2[float64]{Max: {1, 1}})
+ *   returns (RayHit2[float64]{Distance: 5, Point: {0, 0.5}, Normal: {-1, 0}}, true)
+ */
+func (r Ray2[T]) IntersectAABB(b AABB2[T]) (RayHit2[T], bool) {
+	dir := []T{r.Direction.X, r.Direction.Y}
+	t, axis, exit, ok := raySlab([]T{r.Origin.X, r.Origin.Y}, dir, []T{b.Min.X, b.Min.Y}, []T{b.Max.X, b.Max.Y})
+	if !ok {
+		return RayHit2[T]{}, false
+	}
+	normal := []T{0, 0}
+	normal[axis] = slabNormal(dir[axis], exit)
+	return RayHit2[T]{Distance: t, Point: r.At(t), Normal: Vec2[T]{X: normal[0], Y: normal[1]}}, true
+}
+
+/**
+ * IntersectSegment returns the hit of the ray with the line segment from a to b and a boolean indicating whether it
+ * hits at all. The returned normal faces the side of the segment the ray starts on.
+ * For example:
+ *   NewRay2(Vec2[float64]{0, 0}, Vec2[float64]{1, 0}).IntersectSegment(Vec2[float64]{2, -1}, Vec2[float64]{2, 1})
+ *   returns (RayHit2[float64]{Distance: 2, Point: {2, 0}, Normal: {-1, 0}}, true)
+ */
+func (r Ray2[T]) IntersectSegment(a, b Vec2[T]) (RayHit2[T], bool) {
+	e := b.Sub(a)
+	denom := r.Direction.X*e.Y - r.Direction.Y*e.X
+	if Abs(float64(denom)) < rayEpsilon {
+		return RayHit2[T]{}, false
+	}
+	s := a.Sub(r.Origin)
+	t := (s.X*e.Y - s.Y*e.X) / denom
+	u := (s.X*r.Direction.Y - s.Y*r.Direction.X) / denom
+	if t < 0 || u < 0 || u > 1 {
+		return RayHit2[T]{}, false
+	}
+	normal := Vec2[T]{X: e.Y, Y: -e.X}.Norm()
+	if normal.Dot(r.Direction) > 0 {
+		normal = normal.Neg()
+	}
+	return RayHit2[T]{Distance: t, Point: r.At(t), Normal: normal}, true
+}
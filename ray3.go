@@ -0,0 +1,217 @@
+package bm
+
+// rayEpsilon is the tolerance below which a ray is treated as parallel to a surface.
+const rayEpsilon = 1e-10
+
+// Ray3 represents a half-line in 3D space starting at Origin and extending along Direction.
+type Ray3[T Numeric] struct {
+	Origin, Direction Vec3[T]
+}
+
+// RayHit3 describes where a Ray3 hits a shape. Distance is the ray parameter of the hit, which equals the
+// distance along the ray when Direction has unit length. Normal is the unit surface normal at Point.
+type RayHit3[T Numeric] struct {
+	Distance T
+	Point    Vec3[T]
+	Normal   Vec3[T]
+}
+
+// TriangleHit describes where a Ray3 hits a triangle (a, b, c). The barycentric coordinates of the hit
+// are (1-U-V, U, V), so Point equals a*(1-U-V) + b*U + c*V.
+type TriangleHit[T Numeric] struct {
+	RayHit3[T]
+	U, V T
+}
+
+/**
+ * NewRay3 returns the ray starting at origin and extending along direction.
+ * For example:
+ *   NewRay3(Vec3[float64]{0, 0, 0}, Vec3[float64]{0, 0, -1}) returns a ray looking down the negative Z-axis
+ */
+func NewRay3[T Numeric](origin, direction Vec3[T]) Ray3[T] {
+	return Ray3[T]{Origin: origin, Direction: direction}
+}
+
+/**
+ * At returns the point at parameter t along the ray.
+ * For example:
+ *   NewRay3(Vec3[int]{1, 0, 0}, Vec3[int]{0, 2, 0}).At(3) returns Vec3[int]{1, 6, 0}
+ */
+func (r Ray3[T]) At(t T) Vec3[T] {
+	return r.Origin.Add(r.Direction.Scale(t))
+}
+
+/**
+ * IntersectSphere returns the first hit of the ray with the sphere and a boolean indicating whether it hits at all.
+ * If the ray starts inside the sphere, the exit point is returned.
+ * For example:
+ *   NewRay3(Vec3[float64]{0, 0, 5}, Vec3[float64]{0, 0, -1}).IntersectSphere(NewSphere(Vec3[float64]{}, 1))
+ *   returns (RayHit3[float64]{Distance: 4, Point: {0, 0, 1}, Normal: {0, 0, 1}}, true)
+ */
+func (r Ray3[T]) IntersectSphere(s Sphere[T]) (RayHit3[T], bool) {
+	m := r.Origin.Sub(s.Center)
+	a := r.Direction.Dot(r.Direction)
+	b := m.Dot(r.Direction)
+	c := m.Dot(m) - s.Radius*s.Radius
+	if a == 0 || (c > 0 && b > 0) {
+		return RayHit3[T]{}, false
+	}
+	disc := b*b - a*c
+	if disc < 0 {
+		return RayHit3[T]{}, false
+	}
+	sq := Sqrt(disc)
+	t := (-b - sq) / a
+	if t < 0 {
+		t = (-b + sq) / a
+	}
+	p := r.At(t)
+	return RayHit3[T]{Distance: t, Point: p, Normal: p.Sub(s.Center).Norm()}, true
+}
+
+/**
+ * IntersectPlane returns the hit of the ray with the plane and a boolean indicating whether it hits at all.
+ * The returned normal faces the side of the plane the ray starts on.
+ * For example:
+ *   NewRay3(Vec3[float64]{0, 5, 0}, Vec3[float64]{0, -1, 0}).IntersectPlane(NewPlane(Vec3[float64]{0, 1, 0}, 0))
+ *   returns (RayHit3[float64]{Distance: 5, Point: {0, 0, 0}, Normal: {0, 1, 0}}, true)
+ */
+func (r Ray3[T]) IntersectPlane(p Plane[T]) (RayHit3[T], bool) {
+	denom := p.Normal.Dot(r.Direction)
+	if Abs(float64(denom)) < rayEpsilon {
+		return RayHit3[T]{}, false
+	}
+	t := -(p.Normal.Dot(r.Origin) + p.D) / denom
+	if t < 0 {
+		return RayHit3[T]{}, false
+	}
+	normal := p.Normal.Norm()
+	if denom > 0 {
+		normal = normal.Neg()
+	}
+	return RayHit3[T]{Distance: t, Point: r.At(t), Normal: normal}, true
+}
+
+/**
+ * IntersectAABB returns the first hit of the ray with the box using the slab method and a boolean indicating
+ * whether it hits at all. If the ray starts inside the box, the exit point is returned.
+ * For example:
+ *   NewRay3(Vec3[float64]{-5, 0.5, 0.5}, Vec3[float64]{1, 0, 0}).IntersectAABB(AABB3[float64]{Max: {1, 1, 1}})
+ *   returns (RayHit3[float64]{Distance: 5, Point: {0, 0.5, 0.5}, Normal: {-1, 0, 0}}, true)
+ */
+func (r Ray3[T]) IntersectAABB(b AABB3[T]) (RayHit3[T], bool) {
+	origin := []T{r.Origin.X, r.Origin.Y, r.Origin.Z}
+	dir := []T{r.Direction.X, r.Direction.Y, r.Direction.Z}
+	t, axis, exit, ok := raySlab(origin, dir, []T{b.Min.X, b.Min.Y, b.Min.Z}, []T{b.Max.X, b.Max.Y, b.Max.Z})
+	if !ok {
+		return RayHit3[T]{}, false
+	}
+	normal := []T{0, 0, 0}
+	normal[axis] = slabNormal(dir[axis], exit)
+	return RayHit3[T]{
+		Distance: t,
+		Point:    r.At(t),
+		Normal:   Vec3[T]{X: normal[0], Y: normal[1], Z: normal[2]},
+	}, true
+}
+
+/**
+ * IntersectOBB returns the first hit of the ray with the oriented box and a boolean indicating whether it hits at all.
+ * If the ray starts inside the box, the exit point is returned.
+ * For example:
+ *   NewRay3(Vec3[float64]{-5, 0, 0}, Vec3[float64]{1, 0, 0}).IntersectOBB(NewOBB(Vec3[float64]{}, RotateZ(math.Pi/4), Vec3[float64]{1, 1, 1}))
+ *   returns a hit at distance 5 - math.Sqrt2
+ */
+func (r Ray3[T]) IntersectOBB(o OBB[T]) (RayHit3[T], bool) {
+	toLocal := o.Axes.Transpose()
+	local := Ray3[T]{
+		Origin:    toLocal.MulVec(r.Origin.Sub(o.Center)),
+		Direction: toLocal.MulVec(r.Direction),
+	}
+	hit, ok := local.IntersectAABB(AABB3[T]{Min: o.HalfExtents.Neg(), Max: o.HalfExtents})
+	if !ok {
+		return RayHit3[T]{}, false
+	}
+	return RayHit3[T]{Distance: hit.Distance, Point: r.At(hit.Distance), Normal: o.Axes.MulVec(hit.Normal)}, true
+}
+
+/**
+ * IntersectTriangle returns the hit of the ray with the triangle (a, b, c) using the Möller–Trumbore algorithm and a boolean
+ * indicating whether it hits at all. Both faces are hit; the normal follows the winding of a, b, c.
+ * For example:
+ *   NewRay3(Vec3[float64]{0.25, 0.25, 1}, Vec3[float64]{0, 0, -1}).IntersectTriangle(Vec3[float64]{0, 0, 0}, Vec3[float64]{1, 0, 0}, Vec3[float64]{0, 1, 0})
+ *   returns a hit at distance 1 with U = 0.25 and V = 0.25
+ */
+func (r Ray3[T]) IntersectTriangle(a, b, c Vec3[T]) (TriangleHit[T], bool) {
+	e1 := b.Sub(a)
+	e2 := c.Sub(a)
+	p := r.Direction.Cross(e2)
+	det := e1.Dot(p)
+	if Abs(float64(det)) < rayEpsilon {
+		return TriangleHit[T]{}, false
+	}
+	s := r.Origin.Sub(a)
+	u := s.Dot(p) / det
+	if u < 0 || u > 1 {
+		return TriangleHit[T]{}, false
+	}
+	q := s.Cross(e1)
+	v := r.Direction.Dot(q) / det
+	if v < 0 || u+v > 1 {
+		return TriangleHit[T]{}, false
+	}
+	t := e2.Dot(q) / det
+	if t < 0 {
+		return TriangleHit[T]{}, false
+	}
+	return TriangleHit[T]{
+		RayHit3: RayHit3[T]{Distance: t, Point: r.At(t), Normal: e1.Cross(e2).Norm()},
+		U:       u,
+		V:       v,
+	}, true
+}
+
+// raySlab intersects a ray with an axis-aligned box of any dimension. It returns the parameter of the first
+// non-negative crossing, the axis of the face crossed and whether that face is the exit face.
+func raySlab[T Numeric](origin, dir, min, max []T) (t T, axis int, exit bool, ok bool) {
+	var tNear, tFar T
+	nearAxis, farAxis := -1, -1
+	for i := range origin {
+		if Abs(float64(dir[i])) < rayEpsilon {
+			if origin[i] < min[i] || origin[i] > max[i] {
+				return 0, 0, false, false
+			}
+			continue
+		}
+		t1 := (min[i] - origin[i]) / dir[i]
+		t2 := (max[i] - origin[i]) / dir[i]
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		if nearAxis < 0 || t1 > tNear {
+			tNear, nearAxis = t1, i
+		}
+		if farAxis < 0 || t2 < tFar {
+			tFar, farAxis = t2, i
+		}
+		if tNear > tFar || tFar < 0 {
+			return 0, 0, false, false
+		}
+	}
+	if farAxis < 0 {
+		return 0, 0, false, false
+	}
+	if tNear >= 0 {
+		return tNear, nearAxis, false, true
+	}
+	return tFar, farAxis, true, true
+}
+
+// slabNormal returns the normal component of a box face crossed by a ray whose direction component along the face axis is d.
+func slabNormal[T Numeric](d T, exit bool) T {
+	var one T = 1
+	if (d > 0) == exit {
+		return one
+	}
+	return -one
+}
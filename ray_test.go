@@ -0,0 +1,80 @@
+package bm
+
+import (
+	"testing"
+)
+
+// TestRay3Intersect tests ray hits against spheres, planes and boxes.
+func TestRay3Intersect(t *testing.T) {
+	r := NewRay3(NewVec3[float64](0, 0, 5), NewVec3[float64](0, 0, -1))
+
+	tests := []struct {
+		name     string
+		hit      RayHit3[float64]
+		expected RayHit3[float64]
+	}{
+		{"Sphere", first(r.IntersectSphere(NewSphere(NewVec3[float64](0, 0, 0), 1))),
+			RayHit3[float64]{4, NewVec3[float64](0, 0, 1), NewVec3[float64](0, 0, 1)}},
+		{"Plane", first(r.IntersectPlane(NewPlane(NewVec3[float64](0, 0, 1), 2))),
+			RayHit3[float64]{7, NewVec3[float64](0, 0, -2), NewVec3[float64](0, 0, 1)}},
+		{"AABB", first(r.IntersectAABB(AABB3[float64]{Min: NewVec3[float64](-1, -1, -1), Max: NewVec3[float64](1, 1, 1)})),
+			RayHit3[float64]{4, NewVec3[float64](0, 0, 1), NewVec3[float64](0, 0, 1)}},
+		{"OBB", first(r.IntersectOBB(NewOBB(NewVec3[float64](0, 0, 0), RotateX(Pi/4), NewVec3[float64](1, 1, 1)))),
+			RayHit3[float64]{5 - Sqrt(2.0), NewVec3[float64](0, 0, Sqrt(2.0)), NewVec3[float64](0, -Sqrt(0.5), Sqrt(0.5))}},
+	}
+
+	for _, tt := range tests {
+		if !approxEqual(tt.hit.Distance, tt.expected.Distance, 1e-12) ||
+			!vec3ApproxEqual(tt.hit.Point, tt.expected.Point, 1e-12) ||
+			!vec3ApproxEqual(tt.hit.Normal, tt.expected.Normal, 1e-12) {
+			t.Errorf("Ray3 Intersect%s() = %v, want %v", tt.name, tt.hit, tt.expected)
+		}
+	}
+
+	if _, ok := r.IntersectSphere(NewSphere(NewVec3[float64](3, 0, 0), 1)); ok {
+		t.Errorf("Ray3 IntersectSphere() hit a sphere beside the ray")
+	}
+	if _, ok := r.IntersectAABB(AABB3[float64]{Min: NewVec3[float64](1, 1, 6), Max: NewVec3[float64](2, 2, 7)}); ok {
+		t.Errorf("Ray3 IntersectAABB() hit a box behind the ray")
+	}
+	if hit, ok := r.IntersectAABB(AABB3[float64]{Min: NewVec3[float64](-1, -1, 0), Max: NewVec3[float64](1, 1, 10)}); !ok || hit.Distance != 5 {
+		t.Errorf("Ray3 IntersectAABB() from inside = %v, %v, want exit at 5", hit, ok)
+	}
+}
+
+// TestRay3IntersectTriangle tests the Möller–Trumbore intersection and its barycentric coordinates.
+func TestRay3IntersectTriangle(t *testing.T) {
+	a, b, c := NewVec3[float64](0, 0, 0), NewVec3[float64](1, 0, 0), NewVec3[float64](0, 1, 0)
+	r := NewRay3(NewVec3[float64](0.25, 0.5, 1), NewVec3[float64](0, 0, -1))
+
+	hit, ok := r.IntersectTriangle(a, b, c)
+	if !ok || !approxEqual(hit.Distance, 1, 1e-12) || !approxEqual(hit.U, 0.25, 1e-12) || !approxEqual(hit.V, 0.5, 1e-12) {
+		t.Errorf("Ray3 IntersectTriangle() = %v, %v, want distance 1, U 0.25, V 0.5", hit, ok)
+	}
+	if _, ok := NewRay3(NewVec3[float64](1, 1, 1), NewVec3[float64](0, 0, -1)).IntersectTriangle(a, b, c); ok {
+		t.Errorf("Ray3 IntersectTriangle() hit outside the triangle")
+	}
+}
+
+// TestRay2Intersect tests 2D ray hits against circles, boxes and segments.
+func TestRay2Intersect(t *testing.T) {
+	r := NewRay2(NewVec2[float64](-5, 0), NewVec2[float64](1, 0))
+
+	if hit, ok := r.IntersectCircle(NewCircle(NewVec2[float64](0, 0), 1)); !ok || hit.Distance != 4 || hit.Normal != NewVec2[float64](-1, 0) {
+		t.Errorf("Ray2 IntersectCircle() = %v, %v", hit, ok)
+	}
+	if hit, ok := r.IntersectAABB(AABB2[float64]{Min: NewVec2[float64](-1, -1), Max: NewVec2[float64](1, 1)}); !ok || hit.Distance != 4 || hit.Normal != NewVec2[float64](-1, 0) {
+		t.Errorf("Ray2 IntersectAABB() = %v, %v", hit, ok)
+	}
+	if hit, ok := r.IntersectSegment(NewVec2[float64](2, -1), NewVec2[float64](2, 1)); !ok || hit.Distance != 7 || hit.Normal != NewVec2[float64](-1, 0) {
+		t.Errorf("Ray2 IntersectSegment() = %v, %v", hit, ok)
+	}
+	if _, ok := r.IntersectSegment(NewVec2[float64](2, 1), NewVec2[float64](2, 3)); ok {
+		t.Errorf("Ray2 IntersectSegment() hit beyond the segment end")
+	}
+}
+
+// first returns the first of two values, discarding the second.
+func first[A, B any](a A, _ B) A {
+	return a
+}
@@ -0,0 +1,122 @@
+package bm
+
+// segmentEpsilon is the squared length below which a segment is treated as a single point.
+const segmentEpsilon = 1e-12
+
+// LineSegment represents the straight line segment between the points A and B.
+type LineSegment[T Numeric] struct {
+	A, B Vec3[T]
+}
+
+/**
+ * NewLineSegment returns the segment from a to b.
+ * For example:
+ *   NewLineSegment(Vec3[float64]{0, 0, 0}, Vec3[float64]{1, 0, 0}) returns the unit segment along the X-axis
+ */
+func NewLineSegment[T Numeric](a, b Vec3[T]) LineSegment[T] {
+	return LineSegment[T]{A: a, B: b}
+}
+
+/**
+ * Length returns the length of the segment.
+ * For example:
+ *   NewLineSegment(Vec3[float64]{0, 0, 0}, Vec3[float64]{3, 4, 0}).Length() returns 5
+ */
+func (s LineSegment[T]) Length() T {
+	return s.A.Dist(s.B)
+}
+
+/**
+ * At returns the point at parameter t, where 0 is A and 1 is B.
+ * For example:
+ *   NewLineSegment(Vec3[float64]{0, 0, 0}, Vec3[float64]{2, 0, 0}).At(0.5) returns Vec3[float64]{1, 0, 0}
+ */
+func (s LineSegment[T]) At(t T) Vec3[T] {
+	return s.A.Lerp(s.B, t)
+}
+
+/**
+ * ClosestParam returns the parameter in [0, 1] of the point on the segment closest to p.
+ * For example:
+ *   NewLineSegment(Vec3[float64]{0, 0, 0}, Vec3[float64]{2, 0, 0}).ClosestParam(Vec3[float64]{1.5, 3, 0}) returns 0.75
+ */
+func (s LineSegment[T]) ClosestParam(p Vec3[T]) T {
+	d := s.B.Sub(s.A)
+	lenSq := d.Dot(d)
+	if float64(lenSq) <= segmentEpsilon {
+		return 0
+	}
+	return Clamp(p.Sub(s.A).Dot(d)/lenSq, 0, 1)
+}
+
+/**
+ * ClosestPoint returns the point on the segment closest to p.
+ * For example:
+ *   NewLineSegment(Vec3[float64]{0, 0, 0}, Vec3[float64]{2, 0, 0}).ClosestPoint(Vec3[float64]{3, 1, 0}) returns Vec3[float64]{2, 0, 0}
+ */
+func (s LineSegment[T]) ClosestPoint(p Vec3[T]) Vec3[T] {
+	return s.At(s.ClosestParam(p))
+}
+
+/**
+ * Distance returns the distance from p to the nearest point on the segment.
+ * For example:
+ *   NewLineSegment(Vec3[float64]{0, 0, 0}, Vec3[float64]{2, 0, 0}).Distance(Vec3[float64]{1, 3, 4}) returns 5
+ */
+func (s LineSegment[T]) Distance(p Vec3[T]) T {
+	return p.Dist(s.ClosestPoint(p))
+}
+
+/**
+ * ClosestPoints returns the closest pair of points between the two segments, the first on s and the second on other.
+ * For example:
+ *   NewLineSegment(Vec3[float64]{-1, 0, 0}, Vec3[float64]{1, 0, 0}).ClosestPoints(NewLineSegment(Vec3[float64]{0, -1, 2}, Vec3[float64]{0, 1, 2}))
+ *   returns (Vec3[float64]{0, 0, 0}, Vec3[float64]{0, 0, 2})
+ */
+func (s LineSegment[T]) ClosestPoints(other LineSegment[T]) (Vec3[T], Vec3[T]) {
+	d1 := s.B.Sub(s.A)
+	d2 := other.B.Sub(other.A)
+	r := s.A.Sub(other.A)
+	a := d1.Dot(d1)
+	e := d2.Dot(d2)
+	f := d2.Dot(r)
+
+	var sp, tp T
+	switch {
+	case float64(a) <= segmentEpsilon && float64(e) <= segmentEpsilon:
+		return s.A, other.A
+	case float64(a) <= segmentEpsilon:
+		tp = Clamp(f/e, 0, 1)
+	default:
+		c := d1.Dot(r)
+		if float64(e) <= segmentEpsilon {
+			sp = Clamp(-c/a, 0, 1)
+			break
+		}
+		b := d1.Dot(d2)
+		denom := a*e - b*b
+		if denom != 0 {
+			sp = Clamp((b*f-c*e)/denom, 0, 1)
+		}
+		tp = (b*sp + f) / e
+		if tp < 0 {
+			tp = 0
+			sp = Clamp(-c/a, 0, 1)
+		} else if tp > 1 {
+			tp = 1
+			sp = Clamp((b-c)/a, 0, 1)
+		}
+	}
+	return s.At(sp), other.At(tp)
+}
+
+/**
+ * DistanceSegment returns the shortest distance between the two segments.
+ * For example:
+ *   NewLineSegment(Vec3[float64]{-1, 0, 0}, Vec3[float64]{1, 0, 0}).DistanceSegment(NewLineSegment(Vec3[float64]{0, -1, 2}, Vec3[float64]{0, 1, 2}))
+ *   returns 2
+ */
+func (s LineSegment[T]) DistanceSegment(other LineSegment[T]) T {
+	p, q := s.ClosestPoints(other)
+	return p.Dist(q)
+}
@@ -0,0 +1,395 @@
+package bm
+
+import (
+	"fmt"
+)
+
+// COO is a sparse matrix builder in coordinate format, holding a list of (row, column, value) entries.
+// Entries may be appended in any order, and duplicates are summed when the matrix is compressed.
+type COO[T Numeric] struct {
+	rows, cols int
+	i, j       []int
+	v          []T
+}
+
+// CSR is a sparse matrix in compressed sparse row format. Column indices are sorted and unique within each row.
+// Copies of a CSR share their elements.
+type CSR[T Numeric] struct {
+	rows, cols int
+	indptr     []int
+	indices    []int
+	data       []T
+}
+
+// CSC is a sparse matrix in compressed sparse column format. Row indices are sorted and unique within each column.
+// Copies of a CSC share their elements.
+type CSC[T Numeric] struct {
+	rows, cols int
+	indptr     []int
+	indices    []int
+	data       []T
+}
+
+/**
+ * NewCOO returns an empty rows x cols sparse matrix builder. It panics if the size is negative.
+ * For example:
+ *   NewCOO[float64](3, 3) returns a builder for a 3x3 matrix with no entries
+ */
+func NewCOO[T Numeric](rows, cols int) *COO[T] {
+	if rows < 0 || cols < 0 {
+		panic(fmt.Sprintf("bm: negative matrix size %dx%d", rows, cols))
+	}
+	return &COO[T]{rows: rows, cols: cols}
+}
+
+/**
+ * Append adds v to the element at row i and column j. It panics if the indices are out of range.
+ * For example:
+ *   c.Append(0, 1, 2) followed by c.Append(0, 1, 3) makes the compressed element (0, 1) equal 5
+ */
+func (c *COO[T]) Append(i, j int, v T) {
+	mustIndex(i, j, c.rows, c.cols)
+	c.i = append(c.i, i)
+	c.j = append(c.j, j)
+	c.v = append(c.v, v)
+}
+
+/**
+ * Dims returns the number of rows and columns.
+ * For example:
+ *   NewCOO[float64](2, 3).Dims() returns (2, 3)
+ */
+func (c *COO[T]) Dims() (int, int) {
+	return c.rows, c.cols
+}
+
+/**
+ * Len returns the number of appended entries, counting duplicates separately.
+ * For example:
+ *   NewCOO[float64](2, 2).Len() returns 0
+ */
+func (c *COO[T]) Len() int {
+	return len(c.v)
+}
+
+/**
+ * CSR compresses the entries into compressed sparse row format, summing duplicates.
+ * For example:
+ *   c.CSR().At(i, j) returns the sum of every value appended at (i, j)
+ */
+func (c *COO[T]) CSR() CSR[T] {
+	indptr, indices, data := compress(c.rows, c.cols, c.i, c.j, c.v)
+	return CSR[T]{rows: c.rows, cols: c.cols, indptr: indptr, indices: indices, data: data}
+}
+
+/**
+ * CSC compresses the entries into compressed sparse column format, summing duplicates.
+ * For example:
+ *   c.CSC().At(i, j) returns the sum of every value appended at (i, j)
+ */
+func (c *COO[T]) CSC() CSC[T] {
+	indptr, indices, data := compress(c.cols, c.rows, c.j, c.i, c.v)
+	return CSC[T]{rows: c.rows, cols: c.cols, indptr: indptr, indices: indices, data: data}
+}
+
+/**
+ * Dense returns the entries as a dense matrix, summing duplicates.
+ * For example:
+ *   c.Dense().At(i, j) returns the sum of every value appended at (i, j)
+ */
+func (c *COO[T]) Dense() Dense[T] {
+	d := NewDense[T](c.rows, c.cols, nil)
+	for k, v := range c.v {
+		d.data[c.i[k]*c.cols+c.j[k]] += v
+	}
+	return d
+}
+
+/**
+ * Dims returns the number of rows and columns.
+ * For example:
+ *   NewCOO[float64](2, 3).CSR().Dims() returns (2, 3)
+ */
+func (m CSR[T]) Dims() (int, int) {
+	return m.rows, m.cols
+}
+
+/**
+ * NNZ returns the number of stored elements.
+ * For example:
+ *   NewCOO[float64](2, 2).CSR().NNZ() returns 0
+ */
+func (m CSR[T]) NNZ() int {
+	return len(m.data)
+}
+
+/**
+ * At returns the element at row i and column j, which is 0 if it is not stored. It panics if the indices are out of range.
+ * For example:
+ *   m.At(1, 0) returns 3 if 3 was appended at (1, 0)
+ */
+func (m CSR[T]) At(i, j int) T {
+	mustIndex(i, j, m.rows, m.cols)
+	return compressedAt(m.indptr, m.indices, m.data, i, j)
+}
+
+/**
+ * Diagonal returns the main diagonal of the matrix.
+ * For example:
+ *   the diagonal of a 2x2 CSR holding 4 at (0, 0) and 2 at (0, 1) is Vector[float64]{4, 0}
+ */
+func (m CSR[T]) Diagonal() Vector[T] {
+	diag := make(Vector[T], min(m.rows, m.cols))
+	for i := range diag {
+		diag[i] = compressedAt(m.indptr, m.indices, m.data, i, i)
+	}
+	return diag
+}
+
+/**
+ * MulVec returns the matrix-vector product m * v. It panics if v's length differs from the number of columns.
+ * For example:
+ *   the product of a 2x2 CSR holding {1, 2, 3, 4} in row-major order with Vector[float64]{1, 1} is Vector[float64]{3, 7}
+ */
+func (m CSR[T]) MulVec(v Vector[T]) Vector[T] {
+	v.mustLen(m.cols)
+	out := make(Vector[T], m.rows)
+	for i := range out {
+		var sum T
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			sum += m.data[k] * v[m.indices[k]]
+		}
+		out[i] = sum
+	}
+	return out
+}
+
+/**
+ * Transpose returns the transpose of the matrix in compressed sparse row format.
+ * For example:
+ *   m.Transpose().At(j, i) returns m.At(i, j)
+ */
+func (m CSR[T]) Transpose() CSR[T] {
+	indptr, indices, data := transposeCompressed(m.rows, m.cols, m.indptr, m.indices, m.data)
+	return CSR[T]{rows: m.cols, cols: m.rows, indptr: indptr, indices: indices, data: data}
+}
+
+/**
+ * CSC returns the matrix in compressed sparse column format.
+ * For example:
+ *   m.CSC().At(i, j) returns m.At(i, j)
+ */
+func (m CSR[T]) CSC() CSC[T] {
+	indptr, indices, data := transposeCompressed(m.rows, m.cols, m.indptr, m.indices, m.data)
+	return CSC[T]{rows: m.rows, cols: m.cols, indptr: indptr, indices: indices, data: data}
+}
+
+/**
+ * Dense returns the matrix as a dense matrix.
+ * For example:
+ *   m.Dense().At(i, j) returns m.At(i, j)
+ */
+func (m CSR[T]) Dense() Dense[T] {
+	d := NewDense[T](m.rows, m.cols, nil)
+	for i := 0; i < m.rows; i++ {
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			d.data[i*m.cols+m.indices[k]] = m.data[k]
+		}
+	}
+	return d
+}
+
+/**
+ * Dims returns the number of rows and columns.
+ * For example:
+ *   NewCOO[float64](2, 3).CSC().Dims() returns (2, 3)
+ */
+func (m CSC[T]) Dims() (int, int) {
+	return m.rows, m.cols
+}
+
+/**
+ * NNZ returns the number of stored elements.
+ * For example:
+ *   NewCOO[float64](2, 2).CSC().NNZ() returns 0
+ */
+func (m CSC[T]) NNZ() int {
+	return len(m.data)
+}
+
+/**
+ * At returns the element at row i and column j, which is 0 if it is not stored. It panics if the indices are out of range.
+ * For example:
+ *   m.At(1, 0) returns 3 if 3 was appended at (1, 0)
+ */
+func (m CSC[T]) At(i, j int) T {
+	mustIndex(i, j, m.rows, m.cols)
+	return compressedAt(m.indptr, m.indices, m.data, j, i)
+}
+
+/**
+ * Diagonal returns the main diagonal of the matrix.
+ * For example:
+ *   the diagonal of a 2x2 CSC holding 4 at (0, 0) and 2 at (0, 1) is Vector[float64]{4, 0}
+ */
+func (m CSC[T]) Diagonal() Vector[T] {
+	diag := make(Vector[T], min(m.rows, m.cols))
+	for i := range diag {
+		diag[i] = compressedAt(m.indptr, m.indices, m.data, i, i)
+	}
+	return diag
+}
+
+/**
+ * MulVec returns the matrix-vector product m * v. It panics if v's length differs from the number of columns.
+ * For example:
+ *   the product of a 2x2 CSC holding {1, 2, 3, 4} in row-major order with Vector[float64]{1, 1} is Vector[float64]{3, 7}
+ */
+func (m CSC[T]) MulVec(v Vector[T]) Vector[T] {
+	v.mustLen(m.cols)
+	out := make(Vector[T], m.rows)
+	for j := 0; j < m.cols; j++ {
+		for k := m.indptr[j]; k < m.indptr[j+1]; k++ {
+			out[m.indices[k]] += m.data[k] * v[j]
+		}
+	}
+	return out
+}
+
+/**
+ * Transpose returns the transpose of the matrix in compressed sparse column format.
+ * For example:
+ *   m.Transpose().At(j, i) returns m.At(i, j)
+ */
+func (m CSC[T]) Transpose() CSC[T] {
+	indptr, indices, data := transposeCompressed(m.cols, m.rows, m.indptr, m.indices, m.data)
+	return CSC[T]{rows: m.cols, cols: m.rows, indptr: indptr, indices: indices, data: data}
+}
+
+/**
+ * CSR returns the matrix in compressed sparse row format.
+ * For example:
+ *   m.CSR().At(i, j) returns m.At(i, j)
+ */
+func (m CSC[T]) CSR() CSR[T] {
+	indptr, indices, data := transposeCompressed(m.cols, m.rows, m.indptr, m.indices, m.data)
+	return CSR[T]{rows: m.rows, cols: m.cols, indptr: indptr, indices: indices, data: data}
+}
+
+/**
+ * Dense returns the matrix as a dense matrix.
+ * For example:
+ *   m.Dense().At(i, j) returns m.At(i, j)
+ */
+func (m CSC[T]) Dense() Dense[T] {
+	d := NewDense[T](m.rows, m.cols, nil)
+	for j := 0; j < m.cols; j++ {
+		for k := m.indptr[j]; k < m.indptr[j+1]; k++ {
+			d.data[m.indices[k]*m.cols+j] = m.data[k]
+		}
+	}
+	return d
+}
+
+// compress builds the compressed arrays of a matrix with n major lines and m minor lines from unordered coordinate
+// entries. Two stable counting sorts, by minor then by major index, order the entries before duplicates are summed.
+func compress[T Numeric](n, m int, major, minor []int, vals []T) ([]int, []int, []T) {
+	byMinor := countingSort(m, minor, identityOrder(len(vals)))
+	order := countingSort(n, major, byMinor)
+
+	indptr := make([]int, n+1)
+	indices := make([]int, 0, len(order))
+	data := make([]T, 0, len(order))
+	row := 0
+	for _, k := range order {
+		for row < major[k] {
+			row++
+			indptr[row] = len(data)
+		}
+		if last := len(data) - 1; last >= indptr[row] && indices[last] == minor[k] {
+			data[last] += vals[k]
+			continue
+		}
+		indices = append(indices, minor[k])
+		data = append(data, vals[k])
+	}
+	for row < n {
+		row++
+		indptr[row] = len(data)
+	}
+	return indptr, indices, data
+}
+
+// transposeCompressed converts compressed arrays with n major and m minor lines into the arrays of the transpose,
+// keeping the minor indices sorted.
+func transposeCompressed[T Numeric](n, m int, indptr, indices []int, data []T) ([]int, []int, []T) {
+	outptr := make([]int, m+1)
+	for _, j := range indices {
+		outptr[j+1]++
+	}
+	for j := 0; j < m; j++ {
+		outptr[j+1] += outptr[j]
+	}
+	next := append([]int(nil), outptr[:m]...)
+	outIndices := make([]int, len(indices))
+	outData := make([]T, len(data))
+	for i := 0; i < n; i++ {
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			j := indices[k]
+			outIndices[next[j]] = i
+			outData[next[j]] = data[k]
+			next[j]++
+		}
+	}
+	return outptr, outIndices, outData
+}
+
+// compressedAt returns the element on major line i at minor index j by binary search, or 0 if it is not stored.
+func compressedAt[T Numeric](indptr, indices []int, data []T, i, j int) T {
+	lo, hi := indptr[i], indptr[i+1]
+	for lo < hi {
+		mid := (lo + hi) / 2
+		switch {
+		case indices[mid] < j:
+			lo = mid + 1
+		case indices[mid] > j:
+			hi = mid
+		default:
+			return data[mid]
+		}
+	}
+	return 0
+}
+
+// countingSort stably reorders order by key, whose values lie in [0, n).
+func countingSort(n int, key, order []int) []int {
+	start := make([]int, n+1)
+	for _, k := range order {
+		start[key[k]+1]++
+	}
+	for i := 0; i < n; i++ {
+		start[i+1] += start[i]
+	}
+	out := make([]int, len(order))
+	for _, k := range order {
+		out[start[key[k]]] = k
+		start[key[k]]++
+	}
+	return out
+}
+
+// identityOrder returns the indices 0 to n-1.
+func identityOrder(n int) []int {
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	return order
+}
+
+// mustIndex panics if (i, j) is out of range for a rows x cols matrix.
+func mustIndex(i, j, rows, cols int) {
+	if i < 0 || i >= rows || j < 0 || j >= cols {
+		panic(fmt.Sprintf("bm: index (%d, %d) out of range for %dx%d matrix", i, j, rows, cols))
+	}
+}
@@ -0,0 +1,133 @@
+package bm
+
+import (
+	"errors"
+	"testing"
+)
+
+// gridOperator returns the n² x n² five-point operator shift*I - Δ on an n x n grid, plus a first-order
+// convection term in x. With convection 0 the operator is symmetric positive definite.
+func gridOperator(n int, shift, convection float64) *COO[float64] {
+	c := NewCOO[float64](n*n, n*n)
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			i := y*n + x
+			c.Append(i, i, shift)
+			for _, d := range [][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+				nx, ny := x+d[0], y+d[1]
+				// Each edge is appended as separate diagonal and off-diagonal entries so duplicates get summed.
+				c.Append(i, i, 1)
+				if nx < 0 || nx >= n || ny < 0 || ny >= n {
+					continue
+				}
+				c.Append(i, ny*n+nx, -1+convection*float64(d[0]))
+			}
+		}
+	}
+	return c
+}
+
+// TestSparseFormats tests that the compressed formats agree with the dense matrix.
+func TestSparseFormats(t *testing.T) {
+	c := NewCOO[float64](3, 4)
+	c.Append(2, 3, 1)
+	c.Append(0, 1, 2)
+	c.Append(2, 0, -1)
+	c.Append(0, 1, 3)
+	c.Append(1, 1, 4)
+	want := NewDense(3, 4, []float64{0, 5, 0, 0, 0, 4, 0, 0, -1, 0, 0, 1})
+	if got := c.Dense(); !denseApproxEqual(got, want, 0) {
+		t.Errorf("COO Dense() = %v, want %v", got, want)
+	}
+
+	csr, csc := c.CSR(), c.CSC()
+	if csr.NNZ() != 4 || csc.NNZ() != 4 {
+		t.Errorf("NNZ() = (%d, %d), want 4 after summing duplicates", csr.NNZ(), csc.NNZ())
+	}
+	if got := csr.Dense(); !denseApproxEqual(got, want, 0) {
+		t.Errorf("CSR Dense() = %v, want %v", got, want)
+	}
+	if got := csc.Dense(); !denseApproxEqual(got, want, 0) {
+		t.Errorf("CSC Dense() = %v, want %v", got, want)
+	}
+	if got := csr.CSC().Dense(); !denseApproxEqual(got, want, 0) {
+		t.Errorf("CSR CSC() = %v, want %v", got, want)
+	}
+	if got := csc.CSR().Dense(); !denseApproxEqual(got, want, 0) {
+		t.Errorf("CSC CSR() = %v, want %v", got, want)
+	}
+	if got := csr.Transpose().Dense(); !denseApproxEqual(got, want.Transpose(), 0) {
+		t.Errorf("CSR Transpose() = %v, want %v", got, want.Transpose())
+	}
+	if got := csc.Transpose().Dense(); !denseApproxEqual(got, want.Transpose(), 0) {
+		t.Errorf("CSC Transpose() = %v, want %v", got, want.Transpose())
+	}
+	if csr.At(0, 1) != 5 || csr.At(0, 0) != 0 || csc.At(2, 0) != -1 || csc.At(1, 3) != 0 {
+		t.Errorf("At() returned wrong elements")
+	}
+	if got := csr.Diagonal(); got.Sub(Vector[float64]{0, 4, 0}).Mag() != 0 {
+		t.Errorf("Diagonal() = %v, want [0, 4, 0]", got)
+	}
+
+	v := Vector[float64]{1, -2, 3, 0.5}
+	if got := csr.MulVec(v); got.Sub(want.MulVec(v)).Mag() > 1e-15 {
+		t.Errorf("CSR MulVec() = %v, want %v", got, want.MulVec(v))
+	}
+	if got := csc.MulVec(v); got.Sub(want.MulVec(v)).Mag() > 1e-15 {
+		t.Errorf("CSC MulVec() = %v, want %v", got, want.MulVec(v))
+	}
+
+	empty := NewCOO[float64](2, 2).CSR()
+	if got := empty.MulVec(Vector[float64]{1, 1}); got.Mag() != 0 {
+		t.Errorf("empty MulVec() = %v, want zero", got)
+	}
+}
+
+// TestIterativeSolvers tests CG and BiCGSTAB, with and without preconditioning, on grid operators.
+func TestIterativeSolvers(t *testing.T) {
+	n := 12
+	spd := gridOperator(n, 0.01, 0).CSR()
+	general := gridOperator(n, 0.01, 0.3).CSR()
+	x := make(Vector[float64], n*n)
+	for i := range x {
+		x[i] = float64(i%7) - 3
+	}
+
+	tests := []struct {
+		name  string
+		solve func(LinearOperator[float64], Vector[float64], Preconditioner[float64], float64, int) (Vector[float64], int, error)
+		a     CSR[float64]
+	}{
+		{"ConjugateGradient", ConjugateGradient[float64], spd},
+		{"BiCGSTAB SPD", BiCGSTAB[float64], spd},
+		{"BiCGSTAB nonsymmetric", BiCGSTAB[float64], general},
+	}
+	for _, tt := range tests {
+		b := tt.a.MulVec(x)
+		for _, m := range []Preconditioner[float64]{nil, NewJacobi(tt.a.Diagonal())} {
+			got, iter, err := tt.solve(tt.a, b, m, 1e-10, 0)
+			if err != nil {
+				t.Errorf("%s: error = %v after %d iterations", tt.name, err, iter)
+				continue
+			}
+			if res := tt.a.MulVec(got).Sub(b).Mag(); res > 1e-10*b.Mag() {
+				t.Errorf("%s: residual = %v after %d iterations", tt.name, res, iter)
+			}
+			if got.Sub(x).Mag() > 1e-6*x.Mag() {
+				t.Errorf("%s: solution error = %v", tt.name, got.Sub(x).Mag())
+			}
+		}
+		if _, _, err := tt.solve(tt.a, b, nil, 1e-10, 2); !errors.Is(err, ErrNoConvergence) {
+			t.Errorf("%s: error = %v with 2 iterations, want %v", tt.name, err, ErrNoConvergence)
+		}
+	}
+
+	// Dense matrices are linear operators too.
+	d := NewDense(2, 2, []float64{4, 1, 1, 3})
+	if got, _, err := ConjugateGradient[float64](d, Vector[float64]{1, 2}, nil, 1e-12, 0); err != nil || got.Sub(Vector[float64]{1.0 / 11, 7.0 / 11}).Mag() > 1e-12 {
+		t.Errorf("Dense ConjugateGradient() = (%v, %v), want ([1/11, 7/11], nil)", got, err)
+	}
+	if got, iter, err := ConjugateGradient[float64](d, Vector[float64]{0, 0}, nil, 1e-12, 0); err != nil || iter != 0 || got.Mag() != 0 {
+		t.Errorf("ConjugateGradient() of a zero right-hand side = (%v, %d, %v), want zero", got, iter, err)
+	}
+}
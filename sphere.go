@@ -0,0 +1,103 @@
+package bm
+
+// Sphere represents a solid sphere with the given center and radius.
+type Sphere[T Numeric] struct {
+	Center Vec3[T]
+	Radius T
+}
+
+/**
+ * NewSphere returns the sphere with the given center and radius.
+ * For example:
+ *   NewSphere(Vec3[float64]{0, 0, 0}, 1) returns the unit sphere
+ */
+func NewSphere[T Numeric](center Vec3[T], radius T) Sphere[T] {
+	return Sphere[T]{Center: center, Radius: radius}
+}
+
+/**
+ * Contains reports whether the point p lies inside or on the sphere.
+ * For example:
+ *   NewSphere(Vec3[float64]{}, 1).Contains(Vec3[float64]{0, 1, 0}) returns true
+ */
+func (s Sphere[T]) Contains(p Vec3[T]) bool {
+	d := p.Sub(s.Center)
+	return d.Dot(d) <= s.Radius*s.Radius
+}
+
+/**
+ * Distance returns the signed distance from the surface of the sphere to p, negative inside the sphere.
+ * For example:
+ *   NewSphere(Vec3[float64]{}, 1).Distance(Vec3[float64]{0, 3, 0}) returns 2
+ */
+func (s Sphere[T]) Distance(p Vec3[T]) T {
+	return p.Dist(s.Center) - s.Radius
+}
+
+/**
+ * ClosestPoint returns the point inside or on the sphere closest to p.
+ * For example:
+ *   NewSphere(Vec3[float64]{}, 1).ClosestPoint(Vec3[float64]{0, 3, 0}) returns Vec3[float64]{0, 1, 0}
+ */
+func (s Sphere[T]) ClosestPoint(p Vec3[T]) Vec3[T] {
+	if s.Contains(p) {
+		return p
+	}
+	return s.Center.Add(p.Sub(s.Center).Norm().Scale(s.Radius))
+}
+
+/**
+ * Bounds returns the axis-aligned box enclosing the sphere.
+ * For example:
+ *   NewSphere(Vec3[float64]{1, 0, 0}, 1).Bounds() returns AABB3[float64]{Min: {0, -1, -1}, Max: {2, 1, 1}}
+ */
+func (s Sphere[T]) Bounds() AABB3[T] {
+	return AABB3[T]{Min: s.Center, Max: s.Center}.Expand(s.Radius)
+}
+
+/**
+ * Overlaps reports whether the two spheres intersect or touch.
+ * For example:
+ *   NewSphere(Vec3[float64]{}, 1).Overlaps(NewSphere(Vec3[float64]{2, 0, 0}, 1)) returns true
+ */
+func (s Sphere[T]) Overlaps(other Sphere[T]) bool {
+	d := other.Center.Sub(s.Center)
+	r := s.Radius + other.Radius
+	return d.Dot(d) <= r*r
+}
+
+/**
+ * OverlapsAABB reports whether the sphere intersects or touches the axis-aligned box.
+ * For example:
+ *   NewSphere(Vec3[float64]{2, 0.5, 0.5}, 1).OverlapsAABB(AABB3[float64]{Max: {1, 1, 1}}) returns true
+ */
+func (s Sphere[T]) OverlapsAABB(b AABB3[T]) bool {
+	return s.Contains(b.ClosestPoint(s.Center))
+}
+
+/**
+ * OverlapsOBB reports whether the sphere intersects or touches the oriented box.
+ * For example:
+ *   NewSphere(Vec3[float64]{2, 0, 0}, 0.5).OverlapsOBB(NewOBB(Vec3[float64]{}, RotateZ(math.Pi/4), Vec3[float64]{1, 1, 1})) returns true
+ */
+func (s Sphere[T]) OverlapsOBB(o OBB[T]) bool {
+	return s.Contains(o.ClosestPoint(s.Center))
+}
+
+/**
+ * OverlapsCapsule reports whether the sphere intersects or touches the capsule.
+ * For example:
+ *   NewSphere(Vec3[float64]{0, 2, 0}, 1).OverlapsCapsule(NewCapsule(Vec3[float64]{-1, 0, 0}, Vec3[float64]{1, 0, 0}, 1)) returns true
+ */
+func (s Sphere[T]) OverlapsCapsule(c Capsule[T]) bool {
+	return c.OverlapsSphere(s)
+}
+
+/**
+ * Support returns the point of the sphere farthest along dir, so a Sphere can be used with GJK and EPA.
+ * For example:
+ *   NewSphere(Vec3[float64]{}, 2).Support(Vec3[float64]{0, 5, 0}) returns Vec3[float64]{0, 2, 0}
+ */
+func (s Sphere[T]) Support(dir Vec3[T]) Vec3[T] {
+	return s.Center.Add(dir.Norm().Scale(s.Radius))
+}
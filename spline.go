@@ -0,0 +1,164 @@
+package bm
+
+// Spline is a twice continuously differentiable piecewise cubic interpolant through a set of samples.
+// Coefficients are computed and evaluated in float64. Copies of a Spline share their coefficients.
+type Spline[T Numeric] struct {
+	piecewise[T]
+}
+
+/**
+ * NewNaturalSpline returns the cubic spline through the samples (x[i], y[i]) whose second derivative is zero at both
+ * ends. It returns ErrLength if the slices differ in length or hold fewer than two samples and ErrNotIncreasing if x is
+ * not strictly increasing.
+ * For example:
+ *   NewNaturalSpline([]float64{0, 1, 2}, []float64{0, 1, 0}) returns a spline with At(0.5) equal to 0.6875
+ */
+func NewNaturalSpline[T Numeric](x, y []T) (Spline[T], error) {
+	return newSpline(x, y, func(xs, ys []float64) []float64 {
+		return splineMoments(xs, ys, false, 0, 0)
+	})
+}
+
+/**
+ * NewClampedSpline returns the cubic spline through the samples (x[i], y[i]) whose first derivative is start at the
+ * first knot and end at the last. It returns ErrLength or ErrNotIncreasing as NewNaturalSpline does.
+ * For example:
+ *   NewClampedSpline([]float64{0, 1}, []float64{0, 1}, 0, 0) returns the smoothstep curve 3x² - 2x³
+ */
+func NewClampedSpline[T Numeric](x, y []T, start, end T) (Spline[T], error) {
+	return newSpline(x, y, func(xs, ys []float64) []float64 {
+		return splineMoments(xs, ys, true, float64(start), float64(end))
+	})
+}
+
+/**
+ * NewNotAKnotSpline returns the cubic spline through the samples (x[i], y[i]) whose third derivative is also
+ * continuous at the second and second-to-last knots, which reproduces cubic data exactly. With three samples it is the
+ * parabola through them and with two the line. It returns ErrLength or ErrNotIncreasing as NewNaturalSpline does.
+ * For example:
+ *   NewNotAKnotSpline([]float64{0, 1, 2, 3}, []float64{0, 1, 8, 27}) returns a spline with At(1.5) equal to 3.375
+ */
+func NewNotAKnotSpline[T Numeric](x, y []T) (Spline[T], error) {
+	return newSpline(x, y, notAKnotMoments)
+}
+
+/**
+ * WithExtrapolation returns a copy of the spline that is evaluated outside its knots according to e.
+ * The default is ExtrapolateExtend.
+ * For example:
+ *   s.WithExtrapolation(ExtrapolateConstant).At(x) returns the first or last sample for x outside the knots
+ */
+func (s Spline[T]) WithExtrapolation(e Extrapolation) Spline[T] {
+	s.extrap = e
+	return s
+}
+
+/**
+ * Coefficients returns copies of the knots and the per-segment coefficients in the layout taken by CubicSpline.
+ * For example:
+ *   with x, a, b, c, d := s.Coefficients(), CubicSpline(x, a, b, c, d, v) returns s.At(v) for v inside the knots
+ */
+func (s Spline[T]) Coefficients() (x, a, b, c, d []T) {
+	return toT[T](s.x), toT[T](s.a), toT[T](s.b), toT[T](s.c), toT[T](s.d)
+}
+
+// newSpline validates the samples, computes the second derivatives at the knots with moments and builds the segments.
+func newSpline[T Numeric](x, y []T, moments func(xs, ys []float64) []float64) (Spline[T], error) {
+	xs, ys, err := checkKnots(x, y)
+	if err != nil {
+		return Spline[T]{}, err
+	}
+	m := moments(xs, ys)
+
+	p := newPiecewise[T](xs, ys)
+	for i := 0; i < len(xs)-1; i++ {
+		h := xs[i+1] - xs[i]
+		p.b[i] = (ys[i+1]-ys[i])/h - h*(2*m[i]+m[i+1])/6
+		p.c[i] = m[i] / 2
+		p.d[i] = (m[i+1] - m[i]) / (6 * h)
+	}
+	p.finish()
+	return Spline[T]{p}, nil
+}
+
+// splineMoments solves the tridiagonal system for the second derivatives at the knots of a natural or clamped spline.
+func splineMoments(x, y []float64, clamped bool, start, end float64) []float64 {
+	n := len(x)
+	sub, diag, sup, rhs := make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	for i := 1; i < n-1; i++ {
+		h0, h1 := x[i]-x[i-1], x[i+1]-x[i]
+		sub[i], diag[i], sup[i] = h0, 2*(h0+h1), h1
+		rhs[i] = 6 * ((y[i+1]-y[i])/h1 - (y[i]-y[i-1])/h0)
+	}
+	if clamped {
+		h0, h1 := x[1]-x[0], x[n-1]-x[n-2]
+		diag[0], sup[0] = 2*h0, h0
+		rhs[0] = 6 * ((y[1]-y[0])/h0 - start)
+		sub[n-1], diag[n-1] = h1, 2*h1
+		rhs[n-1] = 6 * (end - (y[n-1]-y[n-2])/h1)
+	} else {
+		diag[0], diag[n-1] = 1, 1
+	}
+	return solveTridiagonal(sub, diag, sup, rhs)
+}
+
+// notAKnotMoments returns the second derivatives at the knots of a not-a-knot spline. The conditions at the ends are
+// eliminated into the first and last interior equations, which keeps the system tridiagonal.
+func notAKnotMoments(x, y []float64) []float64 {
+	n := len(x)
+	m := make([]float64, n)
+	switch n {
+	case 2:
+		return m
+	case 3:
+		// The parabola through the three samples has a constant second derivative.
+		h0, h1 := x[1]-x[0], x[2]-x[1]
+		curvature := 2 * ((y[2]-y[1])/h1 - (y[1]-y[0])/h0) / (h0 + h1)
+		for i := range m {
+			m[i] = curvature
+		}
+		return m
+	}
+
+	k := n - 2
+	sub, diag, sup, rhs := make([]float64, k), make([]float64, k), make([]float64, k), make([]float64, k)
+	for i := 1; i < n-1; i++ {
+		h0, h1 := x[i]-x[i-1], x[i+1]-x[i]
+		sub[i-1], diag[i-1], sup[i-1] = h0, 2*(h0+h1), h1
+		rhs[i-1] = 6 * ((y[i+1]-y[i])/h1 - (y[i]-y[i-1])/h0)
+	}
+	// m[0] = ((h0+h1)*m[1] - h0*m[2]) / h1, and symmetrically at the end.
+	h0, h1 := x[1]-x[0], x[2]-x[1]
+	diag[0] += h0 * (h0 + h1) / h1
+	sup[0] -= h0 * h0 / h1
+	g0, g1 := x[n-2]-x[n-3], x[n-1]-x[n-2]
+	diag[k-1] += g1 * (g0 + g1) / g0
+	sub[k-1] -= g1 * g1 / g0
+
+	copy(m[1:], solveTridiagonal(sub, diag, sup, rhs))
+	m[0] = ((h0+h1)*m[1] - h0*m[2]) / h1
+	m[n-1] = ((g0+g1)*m[n-2] - g1*m[n-3]) / g0
+	return m
+}
+
+// solveTridiagonal solves the tridiagonal system with the given sub-, main and super-diagonals by the Thomas algorithm.
+// sub[0] and sup[len-1] are ignored.
+func solveTridiagonal(sub, diag, sup, rhs []float64) []float64 {
+	n := len(diag)
+	c := make([]float64, n)
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		denom := diag[i]
+		if i > 0 {
+			denom -= sub[i] * c[i-1]
+			x[i] = (rhs[i] - sub[i]*x[i-1]) / denom
+		} else {
+			x[i] = rhs[i] / denom
+		}
+		c[i] = sup[i] / denom
+	}
+	for i := n - 2; i >= 0; i-- {
+		x[i] -= c[i] * x[i+1]
+	}
+	return x
+}
@@ -0,0 +1,171 @@
+package bm
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// TestSplineReproduction tests that each boundary condition reproduces the polynomials it should.
+func TestSplineReproduction(t *testing.T) {
+	x := []float64{-1, 0, 0.5, 2, 3, 4.5}
+	cubic := func(v float64) float64 { return 2 - v + 0.5*v*v - 0.25*v*v*v }
+	slope := func(v float64) float64 { return -1 + v - 0.75*v*v }
+	curvature := func(v float64) float64 { return 1 - 1.5*v }
+	integral := func(v float64) float64 { return 2*v - v*v/2 + v*v*v/6 - v*v*v*v/16 }
+	y := make([]float64, len(x))
+	for i, v := range x {
+		y[i] = cubic(v)
+	}
+
+	clamped, err := NewClampedSpline(x, y, slope(x[0]), slope(x[len(x)-1]))
+	if err != nil {
+		t.Fatalf("NewClampedSpline() error = %v", err)
+	}
+	notAKnot, err := NewNotAKnotSpline(x, y)
+	if err != nil {
+		t.Fatalf("NewNotAKnotSpline() error = %v", err)
+	}
+	for name, s := range map[string]Spline[float64]{"clamped": clamped, "not-a-knot": notAKnot} {
+		for _, v := range []float64{-1, -0.3, 0.25, 1, 2.7, 4.5, 5, -2} {
+			if got := s.At(v); !approxEqual(got, cubic(v), 1e-12) {
+				t.Errorf("%s: At(%v) = %v, want %v", name, v, got, cubic(v))
+			}
+			if got := s.Derivative(v); !approxEqual(got, slope(v), 1e-12) {
+				t.Errorf("%s: Derivative(%v) = %v, want %v", name, v, got, slope(v))
+			}
+			if got := s.SecondDerivative(v); !approxEqual(got, curvature(v), 1e-12) {
+				t.Errorf("%s: SecondDerivative(%v) = %v, want %v", name, v, got, curvature(v))
+			}
+		}
+		if got, want := s.Integrate(-0.5, 4), integral(4)-integral(-0.5); !approxEqual(got, want, 1e-12) {
+			t.Errorf("%s: Integrate(-0.5, 4) = %v, want %v", name, got, want)
+		}
+		if got, want := s.Integrate(5, -2), integral(-2)-integral(5); !approxEqual(got, want, 1e-11) {
+			t.Errorf("%s: Integrate(5, -2) = %v, want %v", name, got, want)
+		}
+	}
+
+	// A natural spline reproduces lines and has zero curvature at its ends.
+	natural, err := NewNaturalSpline(x, y)
+	if err != nil {
+		t.Fatalf("NewNaturalSpline() error = %v", err)
+	}
+	for i, v := range x {
+		if got := natural.At(v); !approxEqual(got, y[i], 1e-12) {
+			t.Errorf("natural: At(%v) = %v, want %v", v, got, y[i])
+		}
+	}
+	if a, b := natural.SecondDerivative(x[0]), natural.SecondDerivative(x[len(x)-1]); !approxEqual(a, 0, 1e-12) || !approxEqual(b, 0, 1e-12) {
+		t.Errorf("natural: end curvature = (%v, %v), want 0", a, b)
+	}
+	line, _ := NewNaturalSpline([]float64{0, 1, 3, 4}, []float64{1, 3, 7, 9})
+	if got := line.At(2.5); !approxEqual(got, 6, 1e-12) {
+		t.Errorf("natural line: At(2.5) = %v, want 6", got)
+	}
+
+	// Continuity of the first two derivatives across interior knots.
+	for _, v := range x[1 : len(x)-1] {
+		for order, f := range []func(float64) float64{natural.At, natural.Derivative, natural.SecondDerivative} {
+			if l, r := f(v-1e-9), f(v+1e-9); !approxEqual(l, r, 1e-6) {
+				t.Errorf("natural: derivative %d jumps at %v: %v != %v", order, v, l, r)
+			}
+		}
+	}
+}
+
+// TestSplineSmallInputs tests the closed-form cases for two and three samples.
+func TestSplineSmallInputs(t *testing.T) {
+	smooth, _ := NewClampedSpline([]float64{0, 1}, []float64{0, 1}, 0, 0)
+	if got := smooth.At(0.25); !approxEqual(got, 3*0.0625-2*0.015625, 1e-15) {
+		t.Errorf("clamped two-point At(0.25) = %v, want the smoothstep value", got)
+	}
+	line, _ := NewNotAKnotSpline([]float64{1, 3}, []float64{2, 6})
+	if got := line.At(2); !approxEqual(got, 4, 1e-15) {
+		t.Errorf("not-a-knot two-point At(2) = %v, want 4", got)
+	}
+	parabola, _ := NewNotAKnotSpline([]float64{0, 1, 3}, []float64{0, 1, 9})
+	if got := parabola.At(2); !approxEqual(got, 4, 1e-12) {
+		t.Errorf("not-a-knot three-point At(2) = %v, want 4", got)
+	}
+	natural, _ := NewNaturalSpline([]float64{0, 1, 2}, []float64{0, 1, 0})
+	if got := natural.At(0.5); !approxEqual(got, 0.6875, 1e-15) {
+		t.Errorf("natural At(0.5) = %v, want 0.6875", got)
+	}
+
+	x, a, b, c, d := natural.Coefficients()
+	for _, v := range []float64{0, 0.3, 1, 1.7, 2} {
+		if got := CubicSpline(x, a, b, c, d, v); !approxEqual(got, natural.At(v), 1e-15) {
+			t.Errorf("CubicSpline() with Coefficients() at %v = %v, want %v", v, got, natural.At(v))
+		}
+	}
+}
+
+// TestSplineExtrapolation tests each extrapolation policy outside the knots.
+func TestSplineExtrapolation(t *testing.T) {
+	s, _ := NewNaturalSpline([]float64{0, 1, 2}, []float64{0, 1, 0})
+	tests := []struct {
+		e                  Extrapolation
+		left, right, deriv float64
+		integral           float64
+		err                error
+	}{
+		{ExtrapolateExtend, s.At(-1), s.At(3), s.Derivative(3), s.Integrate(0, 2) + s.Integrate(2, 3), nil},
+		{ExtrapolateLinear, -1.5, -1.5, -1.5, s.Integrate(0, 2) - 0.75, nil},
+		{ExtrapolateConstant, 0, 0, 0, s.Integrate(0, 2), nil},
+		{ExtrapolateError, 0, 0, 0, s.Integrate(0, 2), ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		e := s.WithExtrapolation(tt.e)
+		if e.Extrapolation() != tt.e {
+			t.Errorf("Extrapolation() = %v, want %v", e.Extrapolation(), tt.e)
+		}
+		if got := e.At(-1); !approxEqual(got, tt.left, 1e-12) {
+			t.Errorf("%v: At(-1) = %v, want %v", tt.e, got, tt.left)
+		}
+		if got := e.At(3); !approxEqual(got, tt.right, 1e-12) {
+			t.Errorf("%v: At(3) = %v, want %v", tt.e, got, tt.right)
+		}
+		if got := e.Derivative(3); !approxEqual(got, tt.deriv, 1e-12) {
+			t.Errorf("%v: Derivative(3) = %v, want %v", tt.e, got, tt.deriv)
+		}
+		if got := e.Integrate(0, 3); !approxEqual(got, tt.integral, 1e-12) {
+			t.Errorf("%v: Integrate(0, 3) = %v, want %v", tt.e, got, tt.integral)
+		}
+		if _, err := e.AtE(3); !errors.Is(err, tt.err) {
+			t.Errorf("%v: AtE(3) error = %v, want %v", tt.e, err, tt.err)
+		}
+		if got, err := e.AtE(1); err != nil || got != 1 {
+			t.Errorf("%v: AtE(1) = (%v, %v), want (1, nil)", tt.e, got, err)
+		}
+	}
+	if got := ExtrapolateConstant.String(); got != "ExtrapolateConstant" {
+		t.Errorf("String() = %q, want %q", got, "ExtrapolateConstant")
+	}
+}
+
+// TestSplineErrors tests that invalid samples are rejected.
+func TestSplineErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y []float64
+		err  error
+	}{
+		{"mismatched lengths", []float64{0, 1, 2}, []float64{0, 1}, ErrLength},
+		{"single sample", []float64{0}, []float64{0}, ErrLength},
+		{"repeated knot", []float64{0, 1, 1}, []float64{0, 1, 2}, ErrNotIncreasing},
+		{"decreasing", []float64{0, 2, 1}, []float64{0, 1, 2}, ErrNotIncreasing},
+		{"NaN knot", []float64{0, math.NaN(), 1}, []float64{0, 1, 2}, ErrNotIncreasing},
+	}
+	for _, tt := range tests {
+		if _, err := NewNaturalSpline(tt.x, tt.y); !errors.Is(err, tt.err) {
+			t.Errorf("%s: NewNaturalSpline() error = %v, want %v", tt.name, err, tt.err)
+		}
+		if _, err := NewClampedSpline(tt.x, tt.y, 0, 0); !errors.Is(err, tt.err) {
+			t.Errorf("%s: NewClampedSpline() error = %v, want %v", tt.name, err, tt.err)
+		}
+		if _, err := NewNotAKnotSpline(tt.x, tt.y); !errors.Is(err, tt.err) {
+			t.Errorf("%s: NewNotAKnotSpline() error = %v, want %v", tt.name, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package bm
+
+// Support3 is implemented by convex 3D shapes. Support returns the point of the shape farthest along dir,
+// which is all GJK and EPA need to know about a shape.
+type Support3[T Numeric] interface {
+	Support(dir Vec3[T]) Vec3[T]
+}
+
+// Support2 is implemented by convex 2D shapes. Support returns the point of the shape farthest along dir.
+type Support2[T Numeric] interface {
+	Support(dir Vec2[T]) Vec2[T]
+}
+
+// SupportFunc3 adapts an ordinary function to the Support3 interface.
+type SupportFunc3[T Numeric] func(dir Vec3[T]) Vec3[T]
+
+/**
+ * Support calls f(dir).
+ * For example:
+ *   SupportFunc3[float64](func(d Vec3[float64]) Vec3[float64] { return d.Norm() }).Support(Vec3[float64]{2, 0, 0}) returns Vec3[float64]{1, 0, 0}
+ */
+func (f SupportFunc3[T]) Support(dir Vec3[T]) Vec3[T] {
+	return f(dir)
+}
+
+// SupportFunc2 adapts an ordinary function to the Support2 interface.
+type SupportFunc2[T Numeric] func(dir Vec2[T]) Vec2[T]
+
+/**
+ * Support calls f(dir).
+ * For example:
+ *   SupportFunc2[float64](func(d Vec2[float64]) Vec2[float64] { return d.Norm() }).Support(Vec2[float64]{2, 0}) returns Vec2[float64]{1, 0}
+ */
+func (f SupportFunc2[T]) Support(dir Vec2[T]) Vec2[T] {
+	return f(dir)
+}
+
+// ConvexHull3 is the convex hull of a set of 3D points. The points do not need to be ordered or to lie on the hull.
+type ConvexHull3[T Numeric] []Vec3[T]
+
+/**
+ * Support returns the point of the hull farthest along dir. An empty hull returns the origin.
+ * For example:
+ *   ConvexHull3[float64]{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}}.Support(Vec3[float64]{1, 0, 0}) returns Vec3[float64]{1, 0, 0}
+ */
+func (h ConvexHull3[T]) Support(dir Vec3[T]) Vec3[T] {
+	var best Vec3[T]
+	for i, p := range h {
+		if i == 0 || p.Dot(dir) > best.Dot(dir) {
+			best = p
+		}
+	}
+	return best
+}
+
+// ConvexHull2 is the convex hull of a set of 2D points. The points do not need to be ordered or to lie on the hull.
+type ConvexHull2[T Numeric] []Vec2[T]
+
+/**
+ * Support returns the point of the hull farthest along dir. An empty hull returns the origin.
+ * For example:
+ *   ConvexHull2[float64]{{0, 0}, {1, 0}, {0, 1}}.Support(Vec2[float64]{0, 1}) returns Vec2[float64]{0, 1}
+ */
+func (h ConvexHull2[T]) Support(dir Vec2[T]) Vec2[T] {
+	var best Vec2[T]
+	for i, p := range h {
+		if i == 0 || p.Dot(dir) > best.Dot(dir) {
+			best = p
+		}
+	}
+	return best
+}
+
+// TransformedShape3 is Shape moved by the affine matrix Matrix.
+type TransformedShape3[T Numeric] struct {
+	Shape  Support3[T]
+	Matrix Mat4[T]
+}
+
+/**
+ * Support returns the point of the transformed shape farthest along dir.
+ * For example:
+ *   TransformedShape3[float64]{NewSphere(Vec3[float64]{}, 1), TranslateMat4(Vec3[float64]{5, 0, 0})}.Support(Vec3[float64]{1, 0, 0}) returns Vec3[float64]{6, 0, 0}
+ */
+func (s TransformedShape3[T]) Support(dir Vec3[T]) Vec3[T] {
+	m := s.Matrix
+	local := Vec3[T]{
+		X: m[0][0]*dir.X + m[1][0]*dir.Y + m[2][0]*dir.Z,
+		Y: m[0][1]*dir.X + m[1][1]*dir.Y + m[2][1]*dir.Z,
+		Z: m[0][2]*dir.X + m[1][2]*dir.Y + m[2][2]*dir.Z,
+	}
+	return m.TransformPoint(s.Shape.Support(local))
+}
+
+// TransformedShape2 is Shape moved by the 2D affine matrix Matrix.
+type TransformedShape2[T Numeric] struct {
+	Shape  Support2[T]
+	Matrix Mat3[T]
+}
+
+/**
+ * Support returns the point of the transformed shape farthest along dir.
+ * For example:
+ *   TransformedShape2[float64]{NewCircle(Vec2[float64]{}, 1), Translate2D(Vec2[float64]{5, 0})}.Support(Vec2[float64]{1, 0}) returns Vec2[float64]{6, 0}
+ */
+func (s TransformedShape2[T]) Support(dir Vec2[T]) Vec2[T] {
+	m := s.Matrix
+	local := Vec2[T]{
+		X: m[0][0]*dir.X + m[1][0]*dir.Y,
+		Y: m[0][1]*dir.X + m[1][1]*dir.Y,
+	}
+	return m.TransformPoint2D(s.Shape.Support(local))
+}
+
+// MinkowskiSum3 is the Minkowski sum of two convex shapes, such as a box swept by a sphere.
+type MinkowskiSum3[T Numeric] struct {
+	A, B Support3[T]
+}
+
+/**
+ * Support returns the point of the Minkowski sum farthest along dir.
+ * For example:
+ *   MinkowskiSum3[float64]{NewSphere(Vec3[float64]{}, 1), NewSphere(Vec3[float64]{}, 2)}.Support(Vec3[float64]{1, 0, 0}) returns Vec3[float64]{3, 0, 0}
+ */
+func (s MinkowskiSum3[T]) Support(dir Vec3[T]) Vec3[T] {
+	return s.A.Support(dir).Add(s.B.Support(dir))
+}
+
+// MinkowskiSum2 is the Minkowski sum of two convex 2D shapes, such as a rounded box.
+type MinkowskiSum2[T Numeric] struct {
+	A, B Support2[T]
+}
+
+/**
+ * Support returns the point of the Minkowski sum farthest along dir.
+ * For example:
+ *   MinkowskiSum2[float64]{NewCircle(Vec2[float64]{}, 1), NewCircle(Vec2[float64]{}, 2)}.Support(Vec2[float64]{1, 0}) returns Vec2[float64]{3, 0}
+ */
+func (s MinkowskiSum2[T]) Support(dir Vec2[T]) Vec2[T] {
+	return s.A.Support(dir).Add(s.B.Support(dir))
+}
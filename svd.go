@@ -0,0 +1,485 @@
+package bm
+
+import (
+	"fmt"
+	"math"
+)
+
+// svdMaxSweeps bounds the number of one-sided Jacobi sweeps; convergence is quadratic, so a handful usually suffices.
+const svdMaxSweeps = 60
+
+// SVD is the thin singular value decomposition of an m x n matrix A, so that A = U*diag(S)*Vᵀ with U m x k, V n x k,
+// both with orthonormal columns, and k = min(m, n). Decompositions are intended for floating-point types.
+type SVD[T Numeric] struct {
+	u, v   Dense[T]
+	values Vector[T]
+}
+
+/**
+ * SVD returns the thin singular value decomposition of the matrix, computed with one-sided Jacobi rotations.
+ * The singular values are in descending order.
+ * For example:
+ *   NewDense(2, 2, []float64{3, 0, 0, -4}).SVD().Values() returns Vector[float64]{4, 3}
+ */
+func (d Dense[T]) SVD() SVD[T] {
+	if d.rows < d.cols {
+		t := d.Transpose().SVD()
+		return SVD[T]{u: t.v, v: t.u, values: t.values}
+	}
+	m, n := d.rows, d.cols
+	u := make([]float64, m*n)
+	for i, x := range d.data {
+		u[i] = float64(x)
+	}
+	v := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		v[i*n+i] = 1
+	}
+	col := func(a []float64, rows, cols, p, q int) (float64, float64, float64) {
+		var alpha, beta, gamma float64
+		for i := 0; i < rows; i++ {
+			x, y := a[i*cols+p], a[i*cols+q]
+			alpha += x * x
+			beta += y * y
+			gamma += x * y
+		}
+		return alpha, beta, gamma
+	}
+	rotate := func(a []float64, rows, cols, p, q int, c, s float64) {
+		for i := 0; i < rows; i++ {
+			x, y := a[i*cols+p], a[i*cols+q]
+			a[i*cols+p] = c*x - s*y
+			a[i*cols+q] = s*x + c*y
+		}
+	}
+
+	// Rotate pairs of columns until all columns of u are mutually orthogonal.
+	for sweep := 0; sweep < svdMaxSweeps; sweep++ {
+		rotated := false
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				alpha, beta, gamma := col(u, m, n, p, q)
+				if math.Abs(gamma) <= 1e-15*math.Sqrt(alpha*beta) {
+					continue
+				}
+				rotated = true
+				zeta := (beta - alpha) / (2 * gamma)
+				t := 1 / (math.Abs(zeta) + math.Sqrt(1+zeta*zeta))
+				if zeta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(1+t*t)
+				rotate(u, m, n, p, q, c, c*t)
+				rotate(v, n, n, p, q, c, c*t)
+			}
+		}
+		if !rotated {
+			break
+		}
+	}
+
+	// The column norms are the singular values; normalizing the columns gives U.
+	values := make([]float64, n)
+	for j := 0; j < n; j++ {
+		values[j], _, _ = col(u, m, n, j, j)
+		values[j] = math.Sqrt(values[j])
+	}
+	for i := 0; i < n-1; i++ {
+		k := i
+		for j := i + 1; j < n; j++ {
+			if values[j] > values[k] {
+				k = j
+			}
+		}
+		if k == i {
+			continue
+		}
+		values[i], values[k] = values[k], values[i]
+		for r := 0; r < m; r++ {
+			u[r*n+i], u[r*n+k] = u[r*n+k], u[r*n+i]
+		}
+		for r := 0; r < n; r++ {
+			v[r*n+i], v[r*n+k] = v[r*n+k], v[r*n+i]
+		}
+	}
+	tol := svdTolerance(values, m, n)
+	for j := 0; j < n; j++ {
+		if values[j] > tol {
+			for i := 0; i < m; i++ {
+				u[i*n+j] /= values[j]
+			}
+		} else {
+			completeBasis(u, m, n, j)
+		}
+	}
+
+	f := SVD[T]{u: NewDense[T](m, n, nil), v: NewDense[T](n, n, nil), values: make(Vector[T], n)}
+	for i, x := range u {
+		f.u.data[i] = T(x)
+	}
+	for i, x := range v {
+		f.v.data[i] = T(x)
+	}
+	for i, x := range values {
+		f.values[i] = T(x)
+	}
+	return f
+}
+
+/**
+ * SVD returns the singular value decomposition of the matrix.
+ * For example:
+ *   Mat2[float64]{ {0, 2}, {1, 0} }.SVD().Values() returns Vector[float64]{2, 1}
+ */
+func (m Mat2[T]) SVD() SVD[T] {
+	return DenseFromMat2(m).SVD()
+}
+
+/**
+ * SVD returns the singular value decomposition of the matrix.
+ * For example:
+ *   RotateZ[float64](1).SVD().Values() returns Vector[float64]{1, 1, 1}
+ */
+func (m Mat3[T]) SVD() SVD[T] {
+	return DenseFromMat3(m).SVD()
+}
+
+/**
+ * SVD returns the singular value decomposition of the matrix.
+ * For example:
+ *   ScaleMat4(Vec3[float64]{1, 2, 3}).SVD().Values() returns Vector[float64]{3, 2, 1, 1}
+ */
+func (m Mat4[T]) SVD() SVD[T] {
+	return DenseFromMat4(m).SVD()
+}
+
+/**
+ * U returns the m x k matrix of left singular vectors.
+ * For example:
+ *   f.U().Mul(f.S()).Mul(f.V().Transpose()) returns the decomposed matrix
+ */
+func (f SVD[T]) U() Dense[T] {
+	return f.u.Clone()
+}
+
+/**
+ * V returns the n x k matrix of right singular vectors.
+ * For example:
+ *   f.U().Mul(f.S()).Mul(f.V().Transpose()) returns the decomposed matrix
+ */
+func (f SVD[T]) V() Dense[T] {
+	return f.v.Clone()
+}
+
+/**
+ * S returns the singular values as a k x k diagonal matrix.
+ * For example:
+ *   NewDense(2, 2, []float64{3, 0, 0, -4}).SVD().S() returns the matrix [[4, 0], [0, 3]]
+ */
+func (f SVD[T]) S() Dense[T] {
+	k := len(f.values)
+	s := NewDense[T](k, k, nil)
+	for i, x := range f.values {
+		s.data[i*k+i] = x
+	}
+	return s
+}
+
+/**
+ * Values returns the singular values in descending order.
+ * For example:
+ *   NewDense(2, 2, []float64{3, 0, 0, -4}).SVD().Values() returns Vector[float64]{4, 3}
+ */
+func (f SVD[T]) Values() Vector[T] {
+	return f.values.Clone()
+}
+
+/**
+ * Rank returns the numerical rank: the number of singular values above max(m, n) * σmax * ε, where ε is the float64 machine epsilon.
+ * For example:
+ *   NewDense(3, 3, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9}).SVD().Rank() returns 2
+ */
+func (f SVD[T]) Rank() int {
+	tol := f.tolerance()
+	rank := 0
+	for _, x := range f.values {
+		if float64(x) > tol {
+			rank++
+		}
+	}
+	return rank
+}
+
+/**
+ * Cond returns the 2-norm condition number σmax / σmin, which is +Inf for a singular matrix. Large values mean that
+ * solving with or inverting the matrix loses about log10(Cond()) digits of precision.
+ * For example:
+ *   ScaleMat4(Vec3[float64]{1, 2, 1000}).SVD().Cond() returns 1000
+ */
+func (f SVD[T]) Cond() T {
+	k := len(f.values)
+	if k == 0 {
+		return 0
+	}
+	if f.values[k-1] == 0 {
+		return T(math.Inf(1))
+	}
+	return f.values[0] / f.values[k-1]
+}
+
+/**
+ * PseudoInverse returns the Moore–Penrose pseudoinverse V*diag(1/S)*Uᵀ, treating singular values at or below the
+ * Rank tolerance as zero.
+ * For example:
+ *   NewDense(2, 1, []float64{1, 1}).SVD().PseudoInverse() returns the matrix [[0.5, 0.5]]
+ */
+func (f SVD[T]) PseudoInverse() Dense[T] {
+	tol := f.tolerance()
+	m, k := f.u.rows, len(f.values)
+	n := f.v.rows
+	out := NewDense[T](n, m, nil)
+	for l, s := range f.values {
+		if float64(s) <= tol {
+			continue
+		}
+		inv := 1 / s
+		for i := 0; i < n; i++ {
+			vi := f.v.data[i*k+l] * inv
+			for j := 0; j < m; j++ {
+				out.data[i*m+j] += vi * f.u.data[j*k+l]
+			}
+		}
+	}
+	return out
+}
+
+/**
+ * Inverse returns the inverse V*diag(1/S)*Uᵀ of the decomposed square matrix, or ErrSingular if a singular value is at
+ * or below the Rank tolerance, that is if Cond() exceeds about 1/ε. Unlike LU it rejects nearly singular matrices
+ * whose inverse would be dominated by rounding error. It panics if the matrix is not square.
+ * For example:
+ *   ScaleMat4(Vec3[float64]{1, 1, 1e-20}).SVD().Inverse() returns (Dense[float64]{}, ErrSingular)
+ */
+func (f SVD[T]) Inverse() (Dense[T], error) {
+	if f.u.rows != f.v.rows {
+		panic(fmt.Sprintf("bm: %dx%d matrix is not square", f.u.rows, f.v.rows))
+	}
+	if f.Rank() < len(f.values) {
+		return Dense[T]{}, ErrSingular
+	}
+	return f.PseudoInverse(), nil
+}
+
+/**
+ * PseudoInverse returns the Moore–Penrose pseudoinverse of the matrix using its singular value decomposition.
+ * For example:
+ *   NewDense(2, 1, []float64{1, 1}).PseudoInverse() returns the matrix [[0.5, 0.5]]
+ */
+func (d Dense[T]) PseudoInverse() Dense[T] {
+	return d.SVD().PseudoInverse()
+}
+
+/**
+ * Rank returns the numerical rank of the matrix using its singular value decomposition.
+ * For example:
+ *   NewDense(2, 2, []float64{1, 2, 2, 4}).Rank() returns 1
+ */
+func (d Dense[T]) Rank() int {
+	return d.SVD().Rank()
+}
+
+/**
+ * Cond returns the 2-norm condition number of the matrix using its singular value decomposition.
+ * For example:
+ *   NewDense(2, 2, []float64{1, 0, 0, 100}).Cond() returns 100
+ */
+func (d Dense[T]) Cond() T {
+	return d.SVD().Cond()
+}
+
+/**
+ * PseudoInverse returns the Moore–Penrose pseudoinverse of the matrix, which equals the inverse when the matrix is well conditioned.
+ * For example:
+ *   Mat2[float64]{ {1, 2}, {2, 4} }.PseudoInverse() returns Mat2[float64]{ {0.04, 0.08}, {0.08, 0.16} }
+ */
+func (m Mat2[T]) PseudoInverse() Mat2[T] {
+	return m.SVD().PseudoInverse().Mat2()
+}
+
+/**
+ * PseudoInverse returns the Moore–Penrose pseudoinverse of the matrix, which equals the inverse when the matrix is well conditioned.
+ * For example:
+ *   ScaleMat3(Vec3[float64]{2, 4, 0}).PseudoInverse() returns ScaleMat3(Vec3[float64]{0.5, 0.25, 0})
+ */
+func (m Mat3[T]) PseudoInverse() Mat3[T] {
+	return m.SVD().PseudoInverse().Mat3()
+}
+
+/**
+ * PseudoInverse returns the Moore–Penrose pseudoinverse of the matrix, which equals the inverse when the matrix is well conditioned.
+ * For example:
+ *   ScaleMat4(Vec3[float64]{2, 4, 0}).PseudoInverse() returns ScaleMat4(Vec3[float64]{0.5, 0.25, 0})
+ */
+func (m Mat4[T]) PseudoInverse() Mat4[T] {
+	return m.SVD().PseudoInverse().Mat4()
+}
+
+/**
+ * Rank returns the numerical rank of the matrix.
+ * For example:
+ *   Mat2[float64]{ {1, 2}, {2, 4} }.Rank() returns 1
+ */
+func (m Mat2[T]) Rank() int {
+	return m.SVD().Rank()
+}
+
+/**
+ * Rank returns the numerical rank of the matrix.
+ * For example:
+ *   Mat3[float64]{ {1, 2, 3}, {4, 5, 6}, {7, 8, 9} }.Rank() returns 2
+ */
+func (m Mat3[T]) Rank() int {
+	return m.SVD().Rank()
+}
+
+/**
+ * Rank returns the numerical rank of the matrix.
+ * For example:
+ *   Mat4[float64]{ {1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10, 11, 12}, {13, 14, 15, 16} }.Rank() returns 2
+ */
+func (m Mat4[T]) Rank() int {
+	return m.SVD().Rank()
+}
+
+/**
+ * Cond returns the 2-norm condition number of the matrix.
+ * For example:
+ *   Mat2[float64]{ {1, 0}, {0, 100} }.Cond() returns 100
+ */
+func (m Mat2[T]) Cond() T {
+	return m.SVD().Cond()
+}
+
+/**
+ * Cond returns the 2-norm condition number of the matrix.
+ * For example:
+ *   RotateZ[float64](1).Cond() returns 1
+ */
+func (m Mat3[T]) Cond() T {
+	return m.SVD().Cond()
+}
+
+/**
+ * Cond returns the 2-norm condition number of the matrix. Transforms with a large condition number lose precision when inverted.
+ * For example:
+ *   ScaleMat4(Vec3[float64]{1e-6, 1, 1}).Cond() returns 1e6
+ */
+func (m Mat4[T]) Cond() T {
+	return m.SVD().Cond()
+}
+
+/**
+ * InverseSVD returns the inverse of the matrix using its singular value decomposition, or ErrSingular if the matrix is
+ * numerically singular or so ill-conditioned that its inverse would be dominated by rounding error.
+ * For example:
+ *   NewDense(2, 2, []float64{2, 0, 0, 4}).InverseSVD() returns (the matrix [[0.5, 0], [0, 0.25]], nil)
+ */
+func (d Dense[T]) InverseSVD() (Dense[T], error) {
+	d.mustSquare()
+	return d.SVD().Inverse()
+}
+
+/**
+ * InverseSVD returns the inverse of the matrix using its singular value decomposition, or ErrSingular if the matrix is
+ * numerically singular or so ill-conditioned that its inverse would be dominated by rounding error.
+ * For example:
+ *   Mat2[float64]{ {1, 2}, {2, 4.000000000000001} }.InverseSVD() returns (Mat2[float64]{}, ErrSingular)
+ */
+func (m Mat2[T]) InverseSVD() (Mat2[T], error) {
+	inv, err := m.SVD().Inverse()
+	if err != nil {
+		return Mat2[T]{}, err
+	}
+	return inv.Mat2(), nil
+}
+
+/**
+ * InverseSVD returns the inverse of the matrix using its singular value decomposition, or ErrSingular if the matrix is
+ * numerically singular or so ill-conditioned that its inverse would be dominated by rounding error.
+ * For example:
+ *   ScaleMat3(Vec3[float64]{2, 4, 8}).InverseSVD() returns (ScaleMat3(Vec3[float64]{0.5, 0.25, 0.125}), nil)
+ */
+func (m Mat3[T]) InverseSVD() (Mat3[T], error) {
+	inv, err := m.SVD().Inverse()
+	if err != nil {
+		return Mat3[T]{}, err
+	}
+	return inv.Mat3(), nil
+}
+
+/**
+ * InverseSVD returns the inverse of the matrix using its singular value decomposition, or ErrSingular if the matrix is
+ * numerically singular or so ill-conditioned that its inverse would be dominated by rounding error. Use it instead of
+ * Inverse for transforms that may be nearly singular.
+ * For example:
+ *   ScaleMat4(Vec3[float64]{1, 1, 1e-20}).InverseSVD() returns (Mat4[float64]{}, ErrSingular)
+ */
+func (m Mat4[T]) InverseSVD() (Mat4[T], error) {
+	inv, err := m.SVD().Inverse()
+	if err != nil {
+		return Mat4[T]{}, err
+	}
+	return inv.Mat4(), nil
+}
+
+// tolerance returns the threshold below which a singular value is treated as zero.
+func (f SVD[T]) tolerance() float64 {
+	values := make([]float64, len(f.values))
+	for i, x := range f.values {
+		values[i] = float64(x)
+	}
+	return svdTolerance(values, f.u.rows, f.v.rows)
+}
+
+// svdTolerance returns max(m, n) * σmax * ε for singular values sorted in descending order.
+func svdTolerance(values []float64, m, n int) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	return float64(max(m, n)) * values[0] * 0x1p-52
+}
+
+// completeBasis replaces column j of the m x n row-major matrix u with a unit vector orthogonal to columns 0..j-1,
+// choosing the standard basis vector that keeps the most length after orthogonalization.
+func completeBasis(u []float64, m, n, j int) {
+	best := make([]float64, m)
+	var bestNorm float64
+	cand := make([]float64, m)
+	for e := 0; e < m; e++ {
+		for i := range cand {
+			cand[i] = 0
+		}
+		cand[e] = 1
+		for k := 0; k < j; k++ {
+			var dot float64
+			for i := 0; i < m; i++ {
+				dot += u[i*n+k] * cand[i]
+			}
+			for i := 0; i < m; i++ {
+				cand[i] -= dot * u[i*n+k]
+			}
+		}
+		var norm float64
+		for _, x := range cand {
+			norm += x * x
+		}
+		if norm > bestNorm {
+			bestNorm = norm
+			copy(best, cand)
+		}
+	}
+	bestNorm = math.Sqrt(bestNorm)
+	for i := 0; i < m; i++ {
+		u[i*n+j] = best[i] / bestNorm
+	}
+}
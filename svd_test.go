@@ -0,0 +1,108 @@
+package bm
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// TestSVD tests that the factors reproduce tall, wide and rank-deficient matrices.
+func TestSVD(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Dense[float64]
+		rank int
+	}{
+		{"tall", NewDense(4, 3, []float64{1, 2, 0, -1, 0, 3, 4, 1, 1, 0, 2, -2}), 3},
+		{"wide", NewDense(2, 4, []float64{1, 0, 2, -1, 3, 1, 0, 2}), 2},
+		{"rank deficient", NewDense(3, 3, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9}), 2},
+		{"zero column", NewDense(3, 2, []float64{1, 0, 2, 0, 2, 0}), 1},
+	}
+	for _, tt := range tests {
+		f := tt.a.SVD()
+		u, s, v := f.U(), f.S(), f.V()
+		if got := u.Mul(s).Mul(v.Transpose()); !denseApproxEqual(got, tt.a, 1e-12) {
+			t.Errorf("%s: U*S*Vᵀ = %v, want %v", tt.name, got, tt.a)
+		}
+		_, k := u.Dims()
+		if !denseApproxEqual(u.Transpose().Mul(u), IdentityDense[float64](k), 1e-12) {
+			t.Errorf("%s: Uᵀ*U = %v, want identity", tt.name, u.Transpose().Mul(u))
+		}
+		if !denseApproxEqual(v.Transpose().Mul(v), IdentityDense[float64](k), 1e-12) {
+			t.Errorf("%s: Vᵀ*V = %v, want identity", tt.name, v.Transpose().Mul(v))
+		}
+		values := f.Values()
+		for i := 1; i < len(values); i++ {
+			if values[i] > values[i-1] {
+				t.Errorf("%s: Values() = %v, want descending order", tt.name, values)
+			}
+		}
+		if got := f.Rank(); got != tt.rank {
+			t.Errorf("%s: Rank() = %v, want %v", tt.name, got, tt.rank)
+		}
+
+		// The Moore–Penrose conditions A*A⁺*A = A and A⁺*A*A⁺ = A⁺.
+		pinv := f.PseudoInverse()
+		if got := tt.a.Mul(pinv).Mul(tt.a); !denseApproxEqual(got, tt.a, 1e-12) {
+			t.Errorf("%s: A*A⁺*A = %v, want %v", tt.name, got, tt.a)
+		}
+		if got := pinv.Mul(tt.a).Mul(pinv); !denseApproxEqual(got, pinv, 1e-12) {
+			t.Errorf("%s: A⁺*A*A⁺ = %v, want %v", tt.name, got, pinv)
+		}
+	}
+}
+
+// TestMatSVD tests the fixed-size SVD shortcuts.
+func TestMatSVD(t *testing.T) {
+	if got := ScaleMat4(Vec3[float64]{1, 2, 3}).SVD().Values(); got.Sub(Vector[float64]{3, 2, 1, 1}).Mag() > 1e-12 {
+		t.Errorf("Mat4 SVD() values = %v, want [3, 2, 1, 1]", got)
+	}
+	if got := RotateMat3(Vec3[float64]{1, 2, 3}.Norm(), 0.7).Cond(); !approxEqual(got, 1, 1e-12) {
+		t.Errorf("Mat3 Cond() of a rotation = %v, want 1", got)
+	}
+	if got := ScaleMat4(Vec3[float64]{1e-6, 1, 1}).Cond(); !approxEqual(got, 1e6, 1e-3) {
+		t.Errorf("Mat4 Cond() = %v, want 1e6", got)
+	}
+	if got := (Mat2[float64]{{1, 2}, {2, 4}}).Cond(); got < 1e15 {
+		t.Errorf("Mat2 Cond() of a singular matrix = %v, want a huge value", got)
+	}
+
+	m := Mat4[float64]{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10, 11, 12}, {13, 14, 15, 16}}
+	if got := m.Rank(); got != 2 {
+		t.Errorf("Mat4 Rank() = %v, want 2", got)
+	}
+	if got := m.Mul(m.PseudoInverse()).Mul(m); !mat4ApproxEqual(got, m, 1e-9) {
+		t.Errorf("Mat4 M*M⁺*M = %v, want %v", got, m)
+	}
+
+	invertible := Mat3[float64]{{0, 2, 1}, {1, 0, 3}, {4, 1, 0}}
+	inv, _ := invertible.Inverse()
+	if got := invertible.PseudoInverse(); !mat3ApproxEqual(got, inv, 1e-12) {
+		t.Errorf("Mat3 PseudoInverse() = %v, want the inverse %v", got, inv)
+	}
+	if got := (Mat2[float64]{{1, 2}, {2, 4}}).PseudoInverse(); !approxEqual(got[0][0], 0.04, 1e-12) || !approxEqual(got[1][1], 0.16, 1e-12) {
+		t.Errorf("Mat2 PseudoInverse() = %v, want [[0.04, 0.08], [0.08, 0.16]]", got)
+	}
+	if got := (Mat2[float64]{{1, 0}, {0, 0}}).SVD().Cond(); !math.IsInf(got, 1) {
+		t.Errorf("SVD Cond() of a singular matrix = %v, want +Inf", got)
+	}
+
+	if got, err := invertible.InverseSVD(); err != nil || !mat3ApproxEqual(got, inv, 1e-12) {
+		t.Errorf("Mat3 InverseSVD() = (%v, %v), want (%v, nil)", got, err, inv)
+	}
+	if got, err := NewDense(2, 2, []float64{2, 0, 0, 4}).InverseSVD(); err != nil || !denseApproxEqual(got, NewDense(2, 2, []float64{0.5, 0, 0, 0.25}), 1e-12) {
+		t.Errorf("Dense InverseSVD() = (%v, %v), want ([[0.5, 0], [0, 0.25]], nil)", got, err)
+	}
+	// The triangular matrix has unit pivots, so Inverse succeeds, but its condition number is about 1e20.
+	c := 1e5
+	illConditioned := Mat4[float64]{{1, -c, -c, -c}, {0, 1, -c, -c}, {0, 0, 1, -c}, {0, 0, 0, 1}}
+	if _, ok := illConditioned.Inverse(); !ok {
+		t.Errorf("Mat4 Inverse() of a triangular matrix with unit pivots reported failure")
+	}
+	if _, err := illConditioned.InverseSVD(); !errors.Is(err, ErrSingular) {
+		t.Errorf("Mat4 InverseSVD() of an ill-conditioned matrix error = %v, want %v", err, ErrSingular)
+	}
+	if _, err := (Mat2[float64]{{1, 2}, {2, 4}}).InverseSVD(); !errors.Is(err, ErrSingular) {
+		t.Errorf("Mat2 InverseSVD() of a singular matrix error = %v, want %v", err, ErrSingular)
+	}
+}
@@ -0,0 +1,170 @@
+package bm
+
+import "fmt"
+
+// decomposeEpsilon is the tolerance used by Decompose when checking for shear and projection.
+const decomposeEpsilon = 1e-6
+
+// Transform represents a translation, rotation and scale, applied to points in the order scale, rotate, translate.
+type Transform[T Numeric] struct {
+	Translation Vec3[T]
+	Rotation    Quat[T]
+	Scale       Vec3[T]
+}
+
+/**
+ * NewTransform returns a transform with the given translation, rotation and scale.
+ * For example:
+ *   NewTransform(Vec3[float64]{1, 2, 3}, IdentityQuat[float64](), Vec3[float64]{1, 1, 1}) returns a pure translation
+ */
+func NewTransform[T Numeric](translation Vec3[T], rotation Quat[T], scale Vec3[T]) Transform[T] {
+	return Transform[T]{Translation: translation, Rotation: rotation, Scale: scale}
+}
+
+/**
+ * IdentityTransform returns the transform that leaves every point unchanged.
+ * For example:
+ *   IdentityTransform[float64]().Mat4() returns IdentityMat4[float64]()
+ */
+func IdentityTransform[T Numeric]() Transform[T] {
+	return Transform[T]{Rotation: IdentityQuat[T](), Scale: Vec3[T]{X: 1, Y: 1, Z: 1}}
+}
+
+/**
+ * Mat4 returns the 4x4 matrix translation * rotation * scale equivalent to the transform.
+ * For example:
+ *   NewTransform(Vec3[float64]{1, 2, 3}, IdentityQuat[float64](), Vec3[float64]{2, 2, 2}).Mat4()
+ *   returns Mat4[float64]{ {2, 0, 0, 1}, {0, 2, 0, 2}, {0, 0, 2, 3}, {0, 0, 0, 1} }
+ */
+func (tr Transform[T]) Mat4() Mat4[T] {
+	r := tr.Rotation.Mat3()
+	s := tr.Scale
+	p := tr.Translation
+	return Mat4[T]{
+		{r[0][0] * s.X, r[0][1] * s.Y, r[0][2] * s.Z, p.X},
+		{r[1][0] * s.X, r[1][1] * s.Y, r[1][2] * s.Z, p.Y},
+		{r[2][0] * s.X, r[2][1] * s.Y, r[2][2] * s.Z, p.Z},
+		{0, 0, 0, 1},
+	}
+}
+
+/**
+ * TransformPoint applies scale, rotation and translation to the point p.
+ * For example:
+ *   NewTransform(Vec3[float64]{1, 0, 0}, IdentityQuat[float64](), Vec3[float64]{2, 2, 2}).TransformPoint(Vec3[float64]{1, 1, 1})
+ *   returns Vec3[float64]{3, 2, 2}
+ */
+func (tr Transform[T]) TransformPoint(p Vec3[T]) Vec3[T] {
+	return tr.Rotation.Rotate(p.Mul(tr.Scale)).Add(tr.Translation)
+}
+
+/**
+ * TransformDirection applies scale and rotation to the direction d, ignoring the translation.
+ * For example:
+ *   NewTransform(Vec3[float64]{1, 0, 0}, IdentityQuat[float64](), Vec3[float64]{2, 2, 2}).TransformDirection(Vec3[float64]{1, 1, 1})
+ *   returns Vec3[float64]{2, 2, 2}
+ */
+func (tr Transform[T]) TransformDirection(d Vec3[T]) Vec3[T] {
+	return tr.Rotation.Rotate(d.Mul(tr.Scale))
+}
+
+/**
+ * Mul composes two transforms so that other is applied first and then tr, like tr.Mat4().Mul(other.Mat4()).
+ * The result is exact when tr has uniform scale; otherwise the shear that a matrix product would introduce is dropped.
+ * For example:
+ *   parent.Mul(child) returns the world transform of child
+ */
+func (tr Transform[T]) Mul(other Transform[T]) Transform[T] {
+	return Transform[T]{
+		Translation: tr.TransformPoint(other.Translation),
+		Rotation:    tr.Rotation.Mul(other.Rotation),
+		Scale:       tr.Scale.Mul(other.Scale),
+	}
+}
+
+/**
+ * Inverse returns the inverse transform and a boolean indicating success. If any scale component is zero,
+ * it returns false indicating that the inverse does not exist. The result is exact when the scale is uniform.
+ * For example:
+ *   NewTransform(Vec3[float64]{1, 2, 3}, IdentityQuat[float64](), Vec3[float64]{2, 2, 2}).Inverse()
+ *   returns (NewTransform(Vec3[float64]{-0.5, -1, -1.5}, IdentityQuat[float64](), Vec3[float64]{0.5, 0.5, 0.5}), true)
+ */
+func (tr Transform[T]) Inverse() (Transform[T], bool) {
+	if tr.Scale.X == 0 || tr.Scale.Y == 0 || tr.Scale.Z == 0 {
+		return Transform[T]{}, false
+	}
+	scale := Vec3[T]{X: 1 / tr.Scale.X, Y: 1 / tr.Scale.Y, Z: 1 / tr.Scale.Z}
+	rotation := tr.Rotation.Conjugate()
+	translation := rotation.Rotate(tr.Translation).Mul(scale).Neg()
+	return Transform[T]{Translation: translation, Rotation: rotation, Scale: scale}, true
+}
+
+/**
+ * Lerp interpolates between two transforms, linearly for translation and scale and spherically for rotation.
+ * For example:
+ *   IdentityTransform[float64]().Lerp(other, 0.5) returns the transform halfway to other
+ */
+func (tr Transform[T]) Lerp(other Transform[T], t T) Transform[T] {
+	return Transform[T]{
+		Translation: tr.Translation.Lerp(other.Translation, t),
+		Rotation:    tr.Rotation.Slerp(other.Rotation, t),
+		Scale:       tr.Scale.Lerp(other.Scale, t),
+	}
+}
+
+/**
+ * String returns a string representation of the transform.
+ * For example:
+ *   IdentityTransform[int]().String() returns "{T: {0 0 0}, R: (0, 0, 0, 1), S: {1 1 1}}"
+ */
+func (tr Transform[T]) String() string {
+	return fmt.Sprintf("{T: %v, R: %v, S: %v}", tr.Translation, tr.Rotation, tr.Scale)
+}
+
+/**
+ * Decompose extracts translation, rotation and scale from an affine matrix and a boolean indicating success.
+ * It returns false if the matrix has a projective bottom row, contains shear or has a zero scale, since those cannot be
+ * represented by a Transform. A negative determinant is reported as a negative X scale.
+ * For example:
+ *   TranslateMat4(Vec3[float64]{1, 2, 3}).Rotated(Vec3[float64]{0, 1, 0}, 1).Decompose()
+ *   returns (NewTransform(Vec3[float64]{1, 2, 3}, QuatFromAxisAngle(Vec3[float64]{0, 1, 0}, 1), Vec3[float64]{1, 1, 1}), true)
+ */
+func (m Mat4[T]) Decompose() (Transform[T], bool) {
+	if Abs(float64(m[3][0])) > decomposeEpsilon || Abs(float64(m[3][1])) > decomposeEpsilon ||
+		Abs(float64(m[3][2])) > decomposeEpsilon || Abs(float64(m[3][3])-1) > decomposeEpsilon {
+		return Transform[T]{}, false
+	}
+
+	cols := [3]Vec3[T]{
+		{X: m[0][0], Y: m[1][0], Z: m[2][0]},
+		{X: m[0][1], Y: m[1][1], Z: m[2][1]},
+		{X: m[0][2], Y: m[1][2], Z: m[2][2]},
+	}
+	scale := Vec3[T]{X: cols[0].Mag(), Y: cols[1].Mag(), Z: cols[2].Mag()}
+	if scale.X == 0 || scale.Y == 0 || scale.Z == 0 {
+		return Transform[T]{}, false
+	}
+	if cols[0].Cross(cols[1]).Dot(cols[2]) < 0 {
+		scale.X = -scale.X
+	}
+	cols[0] = cols[0].Scale(1 / scale.X)
+	cols[1] = cols[1].Scale(1 / scale.Y)
+	cols[2] = cols[2].Scale(1 / scale.Z)
+
+	if Abs(float64(cols[0].Dot(cols[1]))) > decomposeEpsilon ||
+		Abs(float64(cols[0].Dot(cols[2]))) > decomposeEpsilon ||
+		Abs(float64(cols[1].Dot(cols[2]))) > decomposeEpsilon {
+		return Transform[T]{}, false
+	}
+
+	rotation := QuatFromMat3(Mat3[T]{
+		{cols[0].X, cols[1].X, cols[2].X},
+		{cols[0].Y, cols[1].Y, cols[2].Y},
+		{cols[0].Z, cols[1].Z, cols[2].Z},
+	})
+	return Transform[T]{
+		Translation: Vec3[T]{X: m[0][3], Y: m[1][3], Z: m[2][3]},
+		Rotation:    rotation.Norm(),
+		Scale:       scale,
+	}, true
+}
@@ -0,0 +1,60 @@
+package bm
+
+import (
+	"testing"
+)
+
+// TestTransformMat4 tests that a transform and its matrix move points the same way.
+func TestTransformMat4(t *testing.T) {
+	tr := NewTransform(
+		NewVec3[float64](1, 2, 3),
+		QuatFromAxisAngle(NewVec3[float64](1, 1, 0), 0.8),
+		NewVec3[float64](2, 3, 4),
+	)
+	p := NewVec3[float64](-1, 0.5, 2)
+
+	if got, want := tr.Mat4().TransformPoint(p), tr.TransformPoint(p); !vec3ApproxEqual(got, want, 1e-12) {
+		t.Errorf("Transform Mat4().TransformPoint() = %v, want %v", got, want)
+	}
+}
+
+// TestTransformMulInverse tests composition and inversion of uniformly scaled transforms.
+func TestTransformMulInverse(t *testing.T) {
+	a := NewTransform(NewVec3[float64](1, 2, 3), QuatFromAxisAngle(NewVec3[float64](0, 1, 0), 0.5), NewVec3[float64](2, 2, 2))
+	b := NewTransform(NewVec3[float64](-4, 0, 1), QuatFromAxisAngle(NewVec3[float64](1, 0, 0), 1.2), NewVec3[float64](1, 2, 3))
+
+	if got, want := a.Mul(b).Mat4(), a.Mat4().Mul(b.Mat4()); !mat4ApproxEqual(got, want, 1e-12) {
+		t.Errorf("Transform Mul() = %v, want %v", got, want)
+	}
+
+	inv, ok := a.Inverse()
+	if !ok {
+		t.Fatalf("Transform Inverse() failed for %v", a)
+	}
+	if got := a.Mul(inv).Mat4(); !mat4ApproxEqual(got, IdentityMat4[float64](), 1e-12) {
+		t.Errorf("Transform Mul(Inverse()) = %v, want identity", got)
+	}
+}
+
+// TestMat4Decompose tests recovering translation, rotation and scale from a matrix.
+func TestMat4Decompose(t *testing.T) {
+	expected := NewTransform(
+		NewVec3[float64](1, 2, 3),
+		QuatFromEuler(0.1, 0.2, 0.3),
+		NewVec3[float64](-2, 3, 4),
+	)
+	tr, ok := expected.Mat4().Decompose()
+	if !ok {
+		t.Fatalf("Mat4 Decompose() failed for %v", expected.Mat4())
+	}
+	if got, want := tr.Mat4(), expected.Mat4(); !mat4ApproxEqual(got, want, 1e-12) {
+		t.Errorf("Mat4 Decompose() = %v, want %v", tr, expected)
+	}
+
+	if _, ok := ShearMat4[float64](1, 0, 0, 0, 0, 0).Decompose(); ok {
+		t.Errorf("Mat4 Decompose() succeeded for a sheared matrix")
+	}
+	if _, ok := Perspective(Pi/2, 1, 1, 3).Decompose(); ok {
+		t.Errorf("Mat4 Decompose() succeeded for a projection matrix")
+	}
+}
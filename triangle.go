@@ -0,0 +1,111 @@
+package bm
+
+// Triangle represents the solid triangle with corners A, B and C.
+type Triangle[T Numeric] struct {
+	A, B, C Vec3[T]
+}
+
+/**
+ * NewTriangle returns the triangle with corners a, b and c.
+ * For example:
+ *   NewTriangle(Vec3[float64]{0, 0, 0}, Vec3[float64]{1, 0, 0}, Vec3[float64]{0, 1, 0}) returns a right triangle in the XY plane
+ */
+func NewTriangle[T Numeric](a, b, c Vec3[T]) Triangle[T] {
+	return Triangle[T]{A: a, B: b, C: c}
+}
+
+/**
+ * Normal returns the unit normal of the triangle, pointing towards the side from which A, B, C appear counter-clockwise.
+ * For example:
+ *   NewTriangle(Vec3[float64]{0, 0, 0}, Vec3[float64]{1, 0, 0}, Vec3[float64]{0, 1, 0}).Normal() returns Vec3[float64]{0, 0, 1}
+ */
+func (tri Triangle[T]) Normal() Vec3[T] {
+	return tri.B.Sub(tri.A).Cross(tri.C.Sub(tri.A)).Norm()
+}
+
+/**
+ * Area returns the area of the triangle.
+ * For example:
+ *   NewTriangle(Vec3[float64]{0, 0, 0}, Vec3[float64]{2, 0, 0}, Vec3[float64]{0, 2, 0}).Area() returns 2
+ */
+func (tri Triangle[T]) Area() T {
+	return tri.B.Sub(tri.A).Cross(tri.C.Sub(tri.A)).Mag() / 2
+}
+
+/**
+ * Centroid returns the average of the three corners.
+ * For example:
+ *   NewTriangle(Vec3[float64]{0, 0, 0}, Vec3[float64]{3, 0, 0}, Vec3[float64]{0, 3, 0}).Centroid() returns Vec3[float64]{1, 1, 0}
+ */
+func (tri Triangle[T]) Centroid() Vec3[T] {
+	return tri.A.Add(tri.B).Add(tri.C).Div(Vec3[T]{X: 3, Y: 3, Z: 3})
+}
+
+/**
+ * Plane returns the plane containing the triangle, with the same normal as Normal.
+ * For example:
+ *   NewTriangle(Vec3[float64]{0, 0, 1}, Vec3[float64]{1, 0, 1}, Vec3[float64]{0, 1, 1}).Plane() returns Plane[float64]{Normal: {0, 0, 1}, D: -1}
+ */
+func (tri Triangle[T]) Plane() Plane[T] {
+	return PlaneFromPoints(tri.A, tri.B, tri.C)
+}
+
+/**
+ * ClosestPoint returns the point on the triangle, including its interior, closest to p.
+ * For example:
+ *   NewTriangle(Vec3[float64]{0, 0, 0}, Vec3[float64]{1, 0, 0}, Vec3[float64]{0, 1, 0}).ClosestPoint(Vec3[float64]{0.25, 0.25, 5})
+ *   returns Vec3[float64]{0.25, 0.25, 0}
+ */
+func (tri Triangle[T]) ClosestPoint(p Vec3[T]) Vec3[T] {
+	a, b, c := tri.A, tri.B, tri.C
+	ab := b.Sub(a)
+	ac := c.Sub(a)
+
+	ap := p.Sub(a)
+	d1 := ab.Dot(ap)
+	d2 := ac.Dot(ap)
+	if d1 <= 0 && d2 <= 0 {
+		return a
+	}
+
+	bp := p.Sub(b)
+	d3 := ab.Dot(bp)
+	d4 := ac.Dot(bp)
+	if d3 >= 0 && d4 <= d3 {
+		return b
+	}
+
+	vc := d1*d4 - d3*d2
+	if vc <= 0 && d1 >= 0 && d3 <= 0 {
+		return a.Add(ab.Scale(d1 / (d1 - d3)))
+	}
+
+	cp := p.Sub(c)
+	d5 := ab.Dot(cp)
+	d6 := ac.Dot(cp)
+	if d6 >= 0 && d5 <= d6 {
+		return c
+	}
+
+	vb := d5*d2 - d1*d6
+	if vb <= 0 && d2 >= 0 && d6 <= 0 {
+		return a.Add(ac.Scale(d2 / (d2 - d6)))
+	}
+
+	va := d3*d6 - d5*d4
+	if va <= 0 && d4-d3 >= 0 && d5-d6 >= 0 {
+		return b.Add(c.Sub(b).Scale((d4 - d3) / ((d4 - d3) + (d5 - d6))))
+	}
+
+	denom := va + vb + vc
+	return a.Add(ab.Scale(vb / denom)).Add(ac.Scale(vc / denom))
+}
+
+/**
+ * Distance returns the distance from p to the nearest point on the triangle.
+ * For example:
+ *   NewTriangle(Vec3[float64]{0, 0, 0}, Vec3[float64]{1, 0, 0}, Vec3[float64]{0, 1, 0}).Distance(Vec3[float64]{0.25, 0.25, 5}) returns 5
+ */
+func (tri Triangle[T]) Distance(p Vec3[T]) T {
+	return p.Dist(tri.ClosestPoint(p))
+}
@@ -28,6 +28,10 @@ func (v Vec2[T]) Scale(scalar T) Vec2[T] {
 	return Vec2[T]{X: v.X * scalar, Y: v.Y * scalar}
 }
 
+func (v Vec2[T]) ScalarDiv(scalar T) Vec2[T] {
+	return Vec2[T]{X: v.X / scalar, Y: v.Y / scalar}
+}
+
 func (v Vec2[T]) Dot(other Vec2[T]) T {
 	return v.X*other.X + v.Y*other.Y
 }
@@ -98,3 +102,17 @@ func (v Vec2[T]) Reflect(normal Vec2[T]) Vec2[T] {
 	dot := v.Dot(normal)
 	return v.Sub(normal.Scale(dot * 2))
 }
+
+func (v Vec2[T]) Min(other Vec2[T]) Vec2[T] {
+	return Vec2[T]{
+		X: Min(v.X, other.X),
+		Y: Min(v.Y, other.Y),
+	}
+}
+
+func (v Vec2[T]) Max(other Vec2[T]) Vec2[T] {
+	return Vec2[T]{
+		X: Max(v.X, other.X),
+		Y: Max(v.Y, other.Y),
+	}
+}
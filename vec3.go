@@ -28,6 +28,10 @@ func (v Vec3[T]) Scale(scalar T) Vec3[T] {
 	return Vec3[T]{X: v.X * scalar, Y: v.Y * scalar, Z: v.Z * scalar}
 }
 
+func (v Vec3[T]) ScalarDiv(scalar T) Vec3[T] {
+	return Vec3[T]{X: v.X / scalar, Y: v.Y / scalar, Z: v.Z / scalar}
+}
+
 func (v Vec3[T]) Dot(other Vec3[T]) T {
 	return v.X*other.X + v.Y*other.Y + v.Z*other.Z
 }
@@ -110,3 +114,19 @@ func (v Vec3[T]) Reflect(normal Vec3[T]) Vec3[T] {
 	dot := v.Dot(normal)
 	return v.Sub(normal.Scale(dot * 2))
 }
+
+func (v Vec3[T]) Min(other Vec3[T]) Vec3[T] {
+	return Vec3[T]{
+		X: Min(v.X, other.X),
+		Y: Min(v.Y, other.Y),
+		Z: Min(v.Z, other.Z),
+	}
+}
+
+func (v Vec3[T]) Max(other Vec3[T]) Vec3[T] {
+	return Vec3[T]{
+		X: Max(v.X, other.X),
+		Y: Max(v.Y, other.Y),
+		Z: Max(v.Z, other.Z),
+	}
+}
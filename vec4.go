@@ -28,6 +28,10 @@ func (v Vec4[T]) Scale(scalar T) Vec4[T] {
 	return Vec4[T]{X: v.X * scalar, Y: v.Y * scalar, Z: v.Z * scalar, W: v.W * scalar}
 }
 
+func (v Vec4[T]) ScalarDiv(scalar T) Vec4[T] {
+	return Vec4[T]{X: v.X / scalar, Y: v.Y / scalar, Z: v.Z / scalar, W: v.W / scalar}
+}
+
 func (v Vec4[T]) Dot(other Vec4[T]) T {
 	return v.X*other.X + v.Y*other.Y + v.Z*other.Z + v.W*other.W
 }
@@ -106,3 +110,21 @@ func (v Vec4[T]) Reflect(normal Vec4[T]) Vec4[T] {
 	dot := v.Dot(normal)
 	return v.Sub(normal.Scale(dot * 2))
 }
+
+func (v Vec4[T]) Min(other Vec4[T]) Vec4[T] {
+	return Vec4[T]{
+		X: Min(v.X, other.X),
+		Y: Min(v.Y, other.Y),
+		Z: Min(v.Z, other.Z),
+		W: Min(v.W, other.W),
+	}
+}
+
+func (v Vec4[T]) Max(other Vec4[T]) Vec4[T] {
+	return Vec4[T]{
+		X: Max(v.X, other.X),
+		Y: Max(v.Y, other.Y),
+		Z: Max(v.Z, other.Z),
+		W: Max(v.W, other.W),
+	}
+}
@@ -0,0 +1,185 @@
+package bm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Vector is a dense vector of arbitrary length, the counterpart of Dense. Operations on vectors of different lengths panic.
+type Vector[T Numeric] []T
+
+/**
+ * NewVector returns a zero vector of length n.
+ * For example:
+ *   NewVector[float64](3) returns Vector[float64]{0, 0, 0}
+ */
+func NewVector[T Numeric](n int) Vector[T] {
+	return make(Vector[T], n)
+}
+
+/**
+ * VectorFromVec2 returns the vector with the components of v.
+ * For example:
+ *   VectorFromVec2(Vec2[int]{1, 2}) returns Vector[int]{1, 2}
+ */
+func VectorFromVec2[T Numeric](v Vec2[T]) Vector[T] {
+	return Vector[T]{v.X, v.Y}
+}
+
+/**
+ * VectorFromVec3 returns the vector with the components of v.
+ * For example:
+ *   VectorFromVec3(Vec3[int]{1, 2, 3}) returns Vector[int]{1, 2, 3}
+ */
+func VectorFromVec3[T Numeric](v Vec3[T]) Vector[T] {
+	return Vector[T]{v.X, v.Y, v.Z}
+}
+
+/**
+ * VectorFromVec4 returns the vector with the components of v.
+ * For example:
+ *   VectorFromVec4(Vec4[int]{1, 2, 3, 4}) returns Vector[int]{1, 2, 3, 4}
+ */
+func VectorFromVec4[T Numeric](v Vec4[T]) Vector[T] {
+	return Vector[T]{v.X, v.Y, v.Z, v.W}
+}
+
+/**
+ * Vec2 returns the vector as a Vec2. It panics if the vector does not have length 2.
+ * For example:
+ *   Vector[int]{1, 2}.Vec2() returns Vec2[int]{1, 2}
+ */
+func (v Vector[T]) Vec2() Vec2[T] {
+	v.mustLen(2)
+	return Vec2[T]{X: v[0], Y: v[1]}
+}
+
+/**
+ * Vec3 returns the vector as a Vec3. It panics if the vector does not have length 3.
+ * For example:
+ *   Vector[int]{1, 2, 3}.Vec3() returns Vec3[int]{1, 2, 3}
+ */
+func (v Vector[T]) Vec3() Vec3[T] {
+	v.mustLen(3)
+	return Vec3[T]{X: v[0], Y: v[1], Z: v[2]}
+}
+
+/**
+ * Vec4 returns the vector as a Vec4. It panics if the vector does not have length 4.
+ * For example:
+ *   Vector[int]{1, 2, 3, 4}.Vec4() returns Vec4[int]{1, 2, 3, 4}
+ */
+func (v Vector[T]) Vec4() Vec4[T] {
+	v.mustLen(4)
+	return Vec4[T]{X: v[0], Y: v[1], Z: v[2], W: v[3]}
+}
+
+/**
+ * Clone returns a copy of the vector that does not share its storage.
+ * For example:
+ *   Vector[int]{1, 2}.Clone() returns Vector[int]{1, 2}
+ */
+func (v Vector[T]) Clone() Vector[T] {
+	return append(Vector[T](nil), v...)
+}
+
+/**
+ * Add returns the component-wise sum of the two vectors.
+ * For example:
+ *   Vector[int]{1, 2}.Add(Vector[int]{3, 4}) returns Vector[int]{4, 6}
+ */
+func (v Vector[T]) Add(other Vector[T]) Vector[T] {
+	v.mustLen(len(other))
+	out := make(Vector[T], len(v))
+	for i := range v {
+		out[i] = v[i] + other[i]
+	}
+	return out
+}
+
+/**
+ * Sub returns the component-wise difference of the two vectors.
+ * For example:
+ *   Vector[int]{4, 6}.Sub(Vector[int]{3, 4}) returns Vector[int]{1, 2}
+ */
+func (v Vector[T]) Sub(other Vector[T]) Vector[T] {
+	v.mustLen(len(other))
+	out := make(Vector[T], len(v))
+	for i := range v {
+		out[i] = v[i] - other[i]
+	}
+	return out
+}
+
+/**
+ * Scale returns the vector multiplied by a scalar.
+ * For example:
+ *   Vector[int]{1, 2}.Scale(3) returns Vector[int]{3, 6}
+ */
+func (v Vector[T]) Scale(scalar T) Vector[T] {
+	out := make(Vector[T], len(v))
+	for i := range v {
+		out[i] = v[i] * scalar
+	}
+	return out
+}
+
+/**
+ * Dot returns the dot product of the two vectors.
+ * For example:
+ *   Vector[int]{1, 2, 3}.Dot(Vector[int]{4, 5, 6}) returns 32
+ */
+func (v Vector[T]) Dot(other Vector[T]) T {
+	v.mustLen(len(other))
+	var sum T
+	for i := range v {
+		sum += v[i] * other[i]
+	}
+	return sum
+}
+
+/**
+ * Mag returns the Euclidean length of the vector.
+ * For example:
+ *   Vector[float64]{3, 4}.Mag() returns 5
+ */
+func (v Vector[T]) Mag() T {
+	return Sqrt(v.Dot(v))
+}
+
+/**
+ * Norm returns the vector scaled to unit length. The zero vector is returned unchanged.
+ * For example:
+ *   Vector[float64]{3, 4}.Norm() returns Vector[float64]{0.6, 0.8}
+ */
+func (v Vector[T]) Norm() Vector[T] {
+	mag := v.Mag()
+	if mag == 0 {
+		return v.Clone()
+	}
+	out := make(Vector[T], len(v))
+	for i := range v {
+		out[i] = v[i] / mag
+	}
+	return out
+}
+
+/**
+ * String returns a string representation of the vector.
+ * For example:
+ *   Vector[int]{1, 2, 3}.String() returns "[1, 2, 3]"
+ */
+func (v Vector[T]) String() string {
+	parts := make([]string, len(v))
+	for i, x := range v {
+		parts[i] = fmt.Sprint(x)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// mustLen panics if the vector does not have length n.
+func (v Vector[T]) mustLen(n int) {
+	if len(v) != n {
+		panic(fmt.Sprintf("bm: vector length mismatch: %d != %d", len(v), n))
+	}
+}